@@ -0,0 +1,19 @@
+# expire all sessions of a stack and fleet
+action "awsappstream_expire_sessions" "all" {
+  config {
+    stack_name = "example-stack"
+    fleet_name = "example-fleet"
+  }
+}
+
+# expire the sessions of a single user
+action "awsappstream_expire_sessions" "user" {
+  config {
+    stack_name          = "example-stack"
+    fleet_name          = "example-fleet"
+    user_id             = "jane.doe@example.com"
+    authentication_type = "USERPOOL"
+  }
+}
+
+# terraform apply -invoke=action.awsappstream_expire_sessions.all
@@ -0,0 +1,20 @@
+action "awsappstream_start_fleet" "example" {
+  config {
+    name = "example-fleet"
+  }
+}
+
+# start the fleet once a new stack has been created
+resource "terraform_data" "example" {
+  input = awsappstream_stack.example.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.awsappstream_start_fleet.example]
+    }
+  }
+}
+
+# or invoke it directly
+# terraform apply -invoke=action.awsappstream_start_fleet.example
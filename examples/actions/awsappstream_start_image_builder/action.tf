@@ -0,0 +1,7 @@
+action "awsappstream_start_image_builder" "example" {
+  config {
+    name = "example-image-builder"
+  }
+}
+
+# terraform apply -invoke=action.awsappstream_start_image_builder.example
@@ -0,0 +1,8 @@
+action "awsappstream_stop_fleet" "example" {
+  config {
+    name = "example-fleet"
+  }
+}
+
+# invoke it directly, for example at the end of a maintenance window
+# terraform apply -invoke=action.awsappstream_stop_fleet.example
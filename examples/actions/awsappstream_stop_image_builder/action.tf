@@ -0,0 +1,17 @@
+action "awsappstream_stop_image_builder" "example" {
+  config {
+    name = "example-image-builder"
+  }
+}
+
+# stop the image builder once the image has been created
+resource "terraform_data" "example" {
+  input = awsappstream_image.example.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.awsappstream_stop_image_builder.example]
+    }
+  }
+}
@@ -0,0 +1,3 @@
+data "awsappstream_app_block_builder" "example" {
+  name = "example-app-block-builder"
+}
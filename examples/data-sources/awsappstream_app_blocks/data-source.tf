@@ -0,0 +1,11 @@
+# all app blocks of the configured region
+data "awsappstream_app_blocks" "all" {}
+
+# app blocks by name tagged for production
+data "awsappstream_app_blocks" "prod" {
+  name_regex = "^prod-"
+
+  tags = {
+    Environment = "prod"
+  }
+}
@@ -0,0 +1,11 @@
+# all applications of the configured region
+data "awsappstream_applications" "all" {}
+
+# applications by name tagged for production
+data "awsappstream_applications" "prod" {
+  name_regex = "^prod-"
+
+  tags = {
+    Environment = "prod"
+  }
+}
@@ -0,0 +1,10 @@
+# all entitlements of a stack
+data "awsappstream_entitlements" "all" {
+  stack_name = "example-stack"
+}
+
+# entitlements of a stack by name
+data "awsappstream_entitlements" "finance" {
+  stack_name = "example-stack"
+  name_regex = "^finance-"
+}
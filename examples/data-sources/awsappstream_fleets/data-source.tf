@@ -0,0 +1,18 @@
+# all fleets of the configured region
+data "awsappstream_fleets" "all" {}
+
+# all running elastic fleets tagged for production
+data "awsappstream_fleets" "prod" {
+  fleet_type = "ELASTIC"
+  state      = "RUNNING"
+
+  tags = {
+    Environment = "prod"
+  }
+}
+
+# fleets by name and instance type
+data "awsappstream_fleets" "example" {
+  name_regex    = "^example-"
+  instance_type = "stream.standard.medium"
+}
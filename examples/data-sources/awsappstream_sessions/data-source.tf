@@ -0,0 +1,13 @@
+# all streaming sessions of a stack and fleet
+data "awsappstream_sessions" "example" {
+  stack_name = "example-stack"
+  fleet_name = "example-fleet"
+}
+
+# streaming sessions of a single user
+data "awsappstream_sessions" "user" {
+  stack_name          = "example-stack"
+  fleet_name          = "example-fleet"
+  user_id             = "jane.doe@example.com"
+  authentication_type = "USERPOOL"
+}
@@ -0,0 +1,11 @@
+# all stacks of the configured region
+data "awsappstream_stacks" "all" {}
+
+# stacks by name tagged for production
+data "awsappstream_stacks" "prod" {
+  name_regex = "^prod-"
+
+  tags = {
+    Environment = "prod"
+  }
+}
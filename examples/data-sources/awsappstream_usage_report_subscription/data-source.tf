@@ -0,0 +1,2 @@
+# appstream usage report subscription of the configured region
+data "awsappstream_usage_report_subscription" "example" {}
@@ -0,0 +1,10 @@
+# all user pool users
+data "awsappstream_users" "all" {
+  authentication_type = "USERPOOL"
+}
+
+# user pool users of a single domain
+data "awsappstream_users" "example" {
+  authentication_type = "USERPOOL"
+  name_regex          = "@example\\.com$"
+}
@@ -0,0 +1,12 @@
+resource "awsappstream_image_builder" "example" {
+  name          = "example-image-builder"
+  instance_type = "stream.standard.medium"
+  image_name    = "AppStream-Windows-Server-2022"
+}
+
+# temporary authoring url that is never written to plan or state
+ephemeral "awsappstream_image_builder_streaming_url" "example" {
+  name                = awsappstream_image_builder.example.name
+  validity            = 3600
+  start_image_builder = true
+}
@@ -0,0 +1,10 @@
+# temporary streaming url that is never written to plan or state
+ephemeral "awsappstream_streaming_url" "example" {
+  stack_name = "example-stack"
+  fleet_name = "example-fleet"
+  user_id    = "jane.doe"
+  validity   = 300
+
+  # launch an application right away instead of the application catalog
+  application_id = "Notepad"
+}
@@ -0,0 +1,23 @@
+# provider configuration assuming a role in the target account
+provider "awsappstream" {
+  region = "eu-central-1"
+
+  assume_role = {
+    role_arn     = "arn:aws:iam::123456789012:role/appstream-deployer"
+    session_name = "terraform"
+    external_id  = "my-external-id"
+    duration     = "1h"
+  }
+}
+
+# provider configuration assuming a role with an OIDC token from CI
+provider "awsappstream" {
+  alias  = "ci"
+  region = "eu-central-1"
+
+  assume_role_with_web_identity = {
+    role_arn                = "arn:aws:iam::123456789012:role/appstream-deployer"
+    session_name            = "ci"
+    web_identity_token_file = "/var/run/secrets/oidc/token"
+  }
+}
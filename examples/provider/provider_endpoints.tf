@@ -0,0 +1,13 @@
+# provider configuration against custom endpoints, e.g. a local mock server
+provider "awsappstream" {
+  region                      = "us-east-1"
+  access_key                  = "mock"
+  secret_access_key           = "mock"
+  skip_credentials_validation = true
+
+  endpoints = {
+    appstream                = "http://localhost:4566"
+    sts                      = "http://localhost:4566"
+    resourcegroupstaggingapi = "http://localhost:4566"
+  }
+}
@@ -3,6 +3,8 @@ provider "awsappstream" {
   profile = "appstream-admin"
   region  = "eu-central-1"
 
+  allowed_account_ids = ["123456789012"]
+
   retry_mode         = "adaptive"
   retry_max_attempts = 10
   retry_max_backoff  = 30
@@ -14,4 +16,9 @@ provider "awsappstream" {
       managed_by  = "terraform"
     }
   }
+
+  ignore_tags = {
+    keys         = ["aws-backup"]
+    key_prefixes = ["cost:"]
+  }
 }
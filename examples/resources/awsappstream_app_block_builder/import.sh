@@ -0,0 +1 @@
+terraform import awsappstream_app_block_builder.example "example-app-block-builder-name"
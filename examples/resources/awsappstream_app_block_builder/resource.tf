@@ -0,0 +1,10 @@
+# minimal app block builder
+resource "awsappstream_app_block_builder" "example" {
+  name          = "example-app-block-builder"
+  platform      = "WINDOWS_SERVER_2019"
+  instance_type = "stream.standard.small"
+
+  vpc_config = {
+    subnet_ids = ["subnet-0abc123def4567890"]
+  }
+}
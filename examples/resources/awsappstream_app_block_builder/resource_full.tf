@@ -0,0 +1,37 @@
+# full app block builder
+resource "awsappstream_app_block_builder" "example" {
+  name          = "example-app-block-builder"
+  display_name  = "Example App Block Builder"
+  description   = "App block builder used to package applications for elastic fleets."
+  platform      = "WINDOWS_SERVER_2019"
+  instance_type = "stream.standard.medium"
+
+  enable_default_internet_access = false
+
+  iam_role_arn = "arn:aws:iam::123456789012:role/AppStreamAppBlockBuilderRole"
+
+  vpc_config = {
+    subnet_ids = [
+      "subnet-0abc123def4567890"
+    ]
+
+    security_group_ids = [
+      "sg-0123456789abcdef0"
+    ]
+  }
+
+  access_endpoints = [
+    {
+      endpoint_type = "STREAMING"
+      vpce_id       = "vpce-0abc123def4567890"
+    }
+  ]
+
+  # park the app block builder while it is not used
+  desired_state = "STOPPED"
+
+  tags = {
+    Environment = "dev"
+    Project     = "appstream"
+  }
+}
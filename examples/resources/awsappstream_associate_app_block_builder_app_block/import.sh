@@ -0,0 +1 @@
+terraform import awsappstream_associate_app_block_builder_app_block.example "example-app-block-builder|arn:aws:appstream:eu-west-1:123456789012:app-block/example-app-block"
@@ -0,0 +1 @@
+terraform import awsappstream_associate_software_image_builder.example "example-image-builder"
@@ -0,0 +1,11 @@
+resource "awsappstream_associate_software_image_builder" "example" {
+  image_builder_name = "example-image-builder"
+
+  software_names = [
+    "Microsoft_Office_2021_LTSC_Professional_Plus_64Bit",
+    "Microsoft_Visio_2021_LTSC_Professional_64Bit",
+  ]
+
+  # install the software right away and wait for the deployment to finish
+  deploy = true
+}
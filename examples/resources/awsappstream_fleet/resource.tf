@@ -42,6 +42,8 @@ resource "awsappstream_fleet" "full" {
 
   enable_default_internet_access = true
 
+  desired_state = "RUNNING"
+
   iam_role_arn = "arn:aws:iam::123456789012:role/AppStreamFleetRole"
 
   stream_view = "DESKTOP"
@@ -0,0 +1 @@
+terraform import awsappstream_image.example "example-image-name"
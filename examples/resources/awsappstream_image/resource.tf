@@ -0,0 +1,15 @@
+import {
+  to = awsappstream_image.example
+  id = "example-image-name"
+}
+
+resource "awsappstream_image" "example" {
+  name = "example-image-name"
+
+  # refuse to delete the image while fleets or image builders still use it
+  force_delete = false
+
+  tags = {
+    Environment = "production"
+  }
+}
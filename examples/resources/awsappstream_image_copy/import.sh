@@ -0,0 +1 @@
+terraform import awsappstream_image_copy.example "us-west-2|example-image-name-dr"
@@ -0,0 +1,6 @@
+resource "awsappstream_image_copy" "example" {
+  source_image_name             = "example-image-name"
+  destination_region            = "us-west-2"
+  destination_image_name        = "example-image-name-dr"
+  destination_image_description = "Disaster recovery copy of example-image-name"
+}
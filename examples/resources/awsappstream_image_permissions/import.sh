@@ -0,0 +1 @@
+terraform import awsappstream_image_permissions.example "example-image-name"
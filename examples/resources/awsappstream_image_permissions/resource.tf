@@ -0,0 +1,16 @@
+resource "awsappstream_image_permissions" "example" {
+  name = "example-image-name"
+
+  shared_accounts = [
+    {
+      account_id          = "111122223333"
+      allow_fleet         = true
+      allow_image_builder = false
+    },
+    {
+      account_id          = "444455556666"
+      allow_fleet         = true
+      allow_image_builder = true
+    }
+  ]
+}
@@ -0,0 +1 @@
+terraform import awsappstream_stack_theme.example "example-stack"
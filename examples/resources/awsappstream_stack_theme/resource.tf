@@ -0,0 +1,30 @@
+resource "awsappstream_stack" "example" {
+  name = "example-stack"
+}
+
+resource "awsappstream_stack_theme" "example" {
+  stack_name    = awsappstream_stack.example.name
+  title_text    = "Example Corp Applications"
+  theme_styling = "LIGHT_BLUE"
+
+  footer_links = [
+    {
+      display_name    = "IT Support"
+      footer_link_url = "https://support.example.com"
+    },
+    {
+      display_name    = "Privacy"
+      footer_link_url = "https://example.com/privacy"
+    }
+  ]
+
+  organization_logo_s3_location = {
+    s3_bucket = "example-branding"
+    s3_key    = "appstream/logo.png"
+  }
+
+  favicon_s3_location = {
+    s3_bucket = "example-branding"
+    s3_key    = "appstream/favicon.ico"
+  }
+}
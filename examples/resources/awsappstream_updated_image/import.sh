@@ -0,0 +1 @@
+terraform import awsappstream_updated_image.example "example-image-name-2025-01"
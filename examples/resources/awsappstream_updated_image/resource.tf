@@ -0,0 +1,24 @@
+resource "awsappstream_updated_image" "example" {
+  existing_image_name    = "example-image-name"
+  new_image_name         = "example-image-name-2025-01"
+  new_image_description  = "example-image-name with the latest agent and Windows updates"
+  new_image_display_name = "Example Image (2025-01)"
+
+  # fail instead of creating an image when no updates are available
+  dry_run = true
+
+  tags = {
+    Environment = "production"
+  }
+}
+
+resource "awsappstream_fleet" "example" {
+  name          = "example-fleet"
+  fleet_type    = "ON_DEMAND"
+  image_arn     = awsappstream_updated_image.example.arn
+  instance_type = "stream.standard.small"
+
+  compute_capacity = {
+    desired_instances = 1
+  }
+}
@@ -0,0 +1 @@
+terraform import awsappstream_usage_report_subscription.example "eu-central-1"
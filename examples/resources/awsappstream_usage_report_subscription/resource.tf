@@ -0,0 +1,6 @@
+# enable appstream usage reports in the configured region
+resource "awsappstream_usage_report_subscription" "example" {}
+
+output "usage_report_bucket" {
+  value = awsappstream_usage_report_subscription.example.s3_bucket_name
+}
@@ -0,0 +1,126 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+)
+
+// AddAppBlockBuilder adds a stopped app block builder. Only its associations with app blocks
+// are modeled by the backend.
+func (b *Backend) AddAppBlockBuilder(name string) *awstypes.AppBlockBuilder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	appBlockBuilder := &awstypes.AppBlockBuilder{
+		Arn:          aws.String(b.arn("app-block-builder", name)),
+		Name:         aws.String(name),
+		InstanceType: aws.String("stream.standard.small"),
+		Platform:     awstypes.AppBlockBuilderPlatformTypeWindowsServer2019,
+		State:        awstypes.AppBlockBuilderStateStopped,
+		CreatedTime:  b.createdTime(),
+	}
+	b.appBlockBuilders[name] = appBlockBuilder
+
+	out := *appBlockBuilder
+	return &out
+}
+
+// AddAppBlock adds an inactive app block with the APPSTREAM2 packaging type. Only its
+// associations with app block builders are modeled by the backend.
+func (b *Backend) AddAppBlock(name string) *awstypes.AppBlock {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	appBlock := &awstypes.AppBlock{
+		Arn:           aws.String(b.arn("app-block", name)),
+		Name:          aws.String(name),
+		PackagingType: awstypes.PackagingTypeAppstream2,
+		State:         awstypes.AppBlockStateInactive,
+		CreatedTime:   b.createdTime(),
+	}
+	b.appBlocks[aws.ToString(appBlock.Arn)] = appBlock
+
+	out := *appBlock
+	return &out
+}
+
+func (b *Backend) AssociateAppBlockBuilderAppBlock(
+	_ context.Context, params *awsappstream.AssociateAppBlockBuilderAppBlockInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.AssociateAppBlockBuilderAppBlockOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.AppBlockBuilderName)
+	arn := aws.ToString(params.AppBlockArn)
+
+	if _, ok := b.appBlockBuilders[name]; !ok {
+		return nil, errResourceNotFound("app block builder %s not found", name)
+	}
+
+	if _, ok := b.appBlocks[arn]; !ok {
+		return nil, errResourceNotFound("app block %s not found", arn)
+	}
+
+	addToSet(b.appBlockBuilderAppBlocks, name, arn)
+
+	return &awsappstream.AssociateAppBlockBuilderAppBlockOutput{
+		AppBlockBuilderAppBlockAssociation: &awstypes.AppBlockBuilderAppBlockAssociation{
+			AppBlockBuilderName: aws.String(name),
+			AppBlockArn:         aws.String(arn),
+		},
+	}, nil
+}
+
+func (b *Backend) DisassociateAppBlockBuilderAppBlock(
+	_ context.Context, params *awsappstream.DisassociateAppBlockBuilderAppBlockInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DisassociateAppBlockBuilderAppBlockOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.AppBlockBuilderName)
+	arn := aws.ToString(params.AppBlockArn)
+
+	if _, ok := b.appBlockBuilderAppBlocks[name][arn]; !ok {
+		return nil, errResourceNotFound("app block %s is not associated with app block builder %s", arn, name)
+	}
+
+	delete(b.appBlockBuilderAppBlocks[name], arn)
+
+	return &awsappstream.DisassociateAppBlockBuilderAppBlockOutput{}, nil
+}
+
+func (b *Backend) DescribeAppBlockBuilderAppBlockAssociations(
+	_ context.Context, params *awsappstream.DescribeAppBlockBuilderAppBlockAssociationsInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DescribeAppBlockBuilderAppBlockAssociationsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := &awsappstream.DescribeAppBlockBuilderAppBlockAssociationsOutput{}
+
+	for _, name := range sortedKeys(b.appBlockBuilderAppBlocks) {
+		if params.AppBlockBuilderName != nil && aws.ToString(params.AppBlockBuilderName) != name {
+			continue
+		}
+
+		for _, arn := range sortedKeys(b.appBlockBuilderAppBlocks[name]) {
+			if params.AppBlockArn != nil && aws.ToString(params.AppBlockArn) != arn {
+				continue
+			}
+
+			out.AppBlockBuilderAppBlockAssociations = append(out.AppBlockBuilderAppBlockAssociations,
+				awstypes.AppBlockBuilderAppBlockAssociation{
+					AppBlockBuilderName: aws.String(name),
+					AppBlockArn:         aws.String(arn),
+				},
+			)
+		}
+	}
+
+	return out, nil
+}
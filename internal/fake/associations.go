@@ -0,0 +1,335 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+)
+
+func (b *Backend) AssociateFleet(
+	_ context.Context, params *awsappstream.AssociateFleetInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.AssociateFleetOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	fleetName := aws.ToString(params.FleetName)
+	stackName := aws.ToString(params.StackName)
+
+	if _, ok := b.fleets[fleetName]; !ok {
+		return nil, errResourceNotFound("fleet %s not found", fleetName)
+	}
+	if _, ok := b.stacks[stackName]; !ok {
+		return nil, errResourceNotFound("stack %s not found", stackName)
+	}
+
+	addToSet(b.fleetStacks, fleetName, stackName)
+
+	return &awsappstream.AssociateFleetOutput{}, nil
+}
+
+func (b *Backend) DisassociateFleet(
+	_ context.Context, params *awsappstream.DisassociateFleetInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DisassociateFleetOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	fleetName := aws.ToString(params.FleetName)
+	stackName := aws.ToString(params.StackName)
+
+	if _, ok := b.fleetStacks[fleetName][stackName]; !ok {
+		return nil, errResourceNotFound("fleet %s is not associated with stack %s", fleetName, stackName)
+	}
+
+	delete(b.fleetStacks[fleetName], stackName)
+
+	return &awsappstream.DisassociateFleetOutput{}, nil
+}
+
+func (b *Backend) ListAssociatedStacks(
+	_ context.Context, params *awsappstream.ListAssociatedStacksInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.ListAssociatedStacksOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	fleetName := aws.ToString(params.FleetName)
+	if _, ok := b.fleets[fleetName]; !ok {
+		return nil, errResourceNotFound("fleet %s not found", fleetName)
+	}
+
+	names, nextToken, err := page(sortedKeys(b.fleetStacks[fleetName]), params.NextToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &awsappstream.ListAssociatedStacksOutput{Names: names, NextToken: nextToken}, nil
+}
+
+func (b *Backend) ListAssociatedFleets(
+	_ context.Context, params *awsappstream.ListAssociatedFleetsInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.ListAssociatedFleetsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stackName := aws.ToString(params.StackName)
+	if _, ok := b.stacks[stackName]; !ok {
+		return nil, errResourceNotFound("stack %s not found", stackName)
+	}
+
+	var fleetNames []string
+	for _, fleetName := range sortedKeys(b.fleetStacks) {
+		if _, ok := b.fleetStacks[fleetName][stackName]; ok {
+			fleetNames = append(fleetNames, fleetName)
+		}
+	}
+
+	names, nextToken, err := page(fleetNames, params.NextToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &awsappstream.ListAssociatedFleetsOutput{Names: names, NextToken: nextToken}, nil
+}
+
+func (b *Backend) BatchAssociateUserStack(
+	_ context.Context, params *awsappstream.BatchAssociateUserStackInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.BatchAssociateUserStackOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := &awsappstream.BatchAssociateUserStackOutput{}
+
+	for _, association := range params.UserStackAssociations {
+		if errorCode, ok := b.userStackAssociationError(association); !ok {
+			out.Errors = append(out.Errors, awstypes.UserStackAssociationError{
+				ErrorCode:            errorCode,
+				ErrorMessage:         aws.String(string(errorCode)),
+				UserStackAssociation: &association,
+			})
+			continue
+		}
+
+		key := userKey{association.AuthenticationType, aws.ToString(association.UserName)}
+		stacks, ok := b.userStacks[key]
+		if !ok {
+			stacks = make(map[string]bool)
+			b.userStacks[key] = stacks
+		}
+		stacks[aws.ToString(association.StackName)] = aws.ToBool(association.SendEmailNotification)
+	}
+
+	return out, nil
+}
+
+func (b *Backend) BatchDisassociateUserStack(
+	_ context.Context, params *awsappstream.BatchDisassociateUserStackInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.BatchDisassociateUserStackOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := &awsappstream.BatchDisassociateUserStackOutput{}
+
+	for _, association := range params.UserStackAssociations {
+		if errorCode, ok := b.userStackAssociationError(association); !ok {
+			out.Errors = append(out.Errors, awstypes.UserStackAssociationError{
+				ErrorCode:            errorCode,
+				ErrorMessage:         aws.String(string(errorCode)),
+				UserStackAssociation: &association,
+			})
+			continue
+		}
+
+		key := userKey{association.AuthenticationType, aws.ToString(association.UserName)}
+		delete(b.userStacks[key], aws.ToString(association.StackName))
+	}
+
+	return out, nil
+}
+
+func (b *Backend) DescribeUserStackAssociations(
+	_ context.Context, params *awsappstream.DescribeUserStackAssociationsInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DescribeUserStackAssociationsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := &awsappstream.DescribeUserStackAssociationsOutput{}
+
+	for _, key := range sortedUserKeys(b.userStacks) {
+		if params.AuthenticationType != "" && key.authenticationType != params.AuthenticationType {
+			continue
+		}
+		if params.UserName != nil && key.userName != aws.ToString(params.UserName) {
+			continue
+		}
+
+		for _, stackName := range sortedKeys(b.userStacks[key]) {
+			if params.StackName != nil && stackName != aws.ToString(params.StackName) {
+				continue
+			}
+
+			out.UserStackAssociations = append(out.UserStackAssociations, awstypes.UserStackAssociation{
+				AuthenticationType:    key.authenticationType,
+				StackName:             aws.String(stackName),
+				UserName:              aws.String(key.userName),
+				SendEmailNotification: aws.Bool(b.userStacks[key][stackName]),
+			})
+		}
+	}
+
+	return out, nil
+}
+
+func (b *Backend) AssociateApplicationFleet(
+	_ context.Context, params *awsappstream.AssociateApplicationFleetInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.AssociateApplicationFleetOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	fleetName := aws.ToString(params.FleetName)
+	applicationARN := aws.ToString(params.ApplicationArn)
+
+	if _, ok := b.fleets[fleetName]; !ok {
+		return nil, errResourceNotFound("fleet %s not found", fleetName)
+	}
+
+	addToSet(b.applicationFleets, applicationARN, fleetName)
+
+	return &awsappstream.AssociateApplicationFleetOutput{
+		ApplicationFleetAssociation: &awstypes.ApplicationFleetAssociation{
+			ApplicationArn: aws.String(applicationARN),
+			FleetName:      aws.String(fleetName),
+		},
+	}, nil
+}
+
+func (b *Backend) DisassociateApplicationFleet(
+	_ context.Context, params *awsappstream.DisassociateApplicationFleetInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DisassociateApplicationFleetOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	fleetName := aws.ToString(params.FleetName)
+	applicationARN := aws.ToString(params.ApplicationArn)
+
+	if _, ok := b.applicationFleets[applicationARN][fleetName]; !ok {
+		return nil, errResourceNotFound("application %s is not associated with fleet %s", applicationARN, fleetName)
+	}
+
+	delete(b.applicationFleets[applicationARN], fleetName)
+
+	return &awsappstream.DisassociateApplicationFleetOutput{}, nil
+}
+
+func (b *Backend) DescribeApplicationFleetAssociations(
+	_ context.Context, params *awsappstream.DescribeApplicationFleetAssociationsInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DescribeApplicationFleetAssociationsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := &awsappstream.DescribeApplicationFleetAssociationsOutput{}
+
+	for _, applicationARN := range sortedKeys(b.applicationFleets) {
+		if params.ApplicationArn != nil && applicationARN != aws.ToString(params.ApplicationArn) {
+			continue
+		}
+
+		for _, fleetName := range sortedKeys(b.applicationFleets[applicationARN]) {
+			if params.FleetName != nil && fleetName != aws.ToString(params.FleetName) {
+				continue
+			}
+
+			out.ApplicationFleetAssociations = append(out.ApplicationFleetAssociations, awstypes.ApplicationFleetAssociation{
+				ApplicationArn: aws.String(applicationARN),
+				FleetName:      aws.String(fleetName),
+			})
+		}
+	}
+
+	return out, nil
+}
+
+func (b *Backend) AssociateApplicationToEntitlement(
+	_ context.Context, params *awsappstream.AssociateApplicationToEntitlementInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.AssociateApplicationToEntitlementOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := entitlementKey{aws.ToString(params.StackName), aws.ToString(params.EntitlementName)}
+	if _, ok := b.entitlements[key]; !ok {
+		return nil, errEntitlementNotFound("entitlement %s not found in stack %s", key.name, key.stackName)
+	}
+
+	addToSet(b.entitledApplications, key, aws.ToString(params.ApplicationIdentifier))
+
+	return &awsappstream.AssociateApplicationToEntitlementOutput{}, nil
+}
+
+func (b *Backend) DisassociateApplicationFromEntitlement(
+	_ context.Context, params *awsappstream.DisassociateApplicationFromEntitlementInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DisassociateApplicationFromEntitlementOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := entitlementKey{aws.ToString(params.StackName), aws.ToString(params.EntitlementName)}
+	applicationIdentifier := aws.ToString(params.ApplicationIdentifier)
+
+	if _, ok := b.entitledApplications[key][applicationIdentifier]; !ok {
+		return nil, errEntitlementNotFound(
+			"application %s is not associated with entitlement %s in stack %s",
+			applicationIdentifier, key.name, key.stackName,
+		)
+	}
+
+	delete(b.entitledApplications[key], applicationIdentifier)
+
+	return &awsappstream.DisassociateApplicationFromEntitlementOutput{}, nil
+}
+
+func (b *Backend) ListEntitledApplications(
+	_ context.Context, params *awsappstream.ListEntitledApplicationsInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.ListEntitledApplicationsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := entitlementKey{aws.ToString(params.StackName), aws.ToString(params.EntitlementName)}
+	if _, ok := b.entitlements[key]; !ok {
+		return nil, errEntitlementNotFound("entitlement %s not found in stack %s", key.name, key.stackName)
+	}
+
+	out := &awsappstream.ListEntitledApplicationsOutput{}
+	for _, applicationIdentifier := range sortedKeys(b.entitledApplications[key]) {
+		out.EntitledApplications = append(out.EntitledApplications, awstypes.EntitledApplication{
+			ApplicationIdentifier: aws.String(applicationIdentifier),
+		})
+	}
+
+	return out, nil
+}
+
+func (b *Backend) userStackAssociationError(
+	association awstypes.UserStackAssociation,
+) (awstypes.UserStackAssociationErrorCode, bool) {
+	if _, ok := b.stacks[aws.ToString(association.StackName)]; !ok {
+		return awstypes.UserStackAssociationErrorCodeStackNotFound, false
+	}
+
+	key := userKey{association.AuthenticationType, aws.ToString(association.UserName)}
+	if _, ok := b.users[key]; !ok {
+		return awstypes.UserStackAssociationErrorCodeUserNameNotFound, false
+	}
+
+	return "", true
+}
+
+func addToSet[K comparable](m map[K]map[string]struct{}, key K, value string) {
+	set, ok := m[key]
+	if !ok {
+		set = make(map[string]struct{})
+		m[key] = set
+	}
+	set[value] = struct{}{}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fake provides a stateful in-memory AppStream and tagging backend for
+// running resource tests without AWS.
+package fake
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+)
+
+const (
+	DefaultRegion    = "us-east-1"
+	DefaultAccountID = "123456789012"
+)
+
+var (
+	_ metadata.AppStreamAPI = (*Backend)(nil)
+	_ metadata.TaggingAPI   = (*Backend)(nil)
+)
+
+// Backend is an in-memory implementation of metadata.AppStreamAPI and metadata.TaggingAPI.
+// It supports fleets, stacks, users, entitlements, images, image builders, their associations and tags,
+// as well as associations between app block builders and app blocks.
+// State transitions such as starting or stopping a fleet complete immediately.
+type Backend struct {
+	mu sync.Mutex
+
+	region    string
+	accountID string
+	now       func() time.Time
+
+	fleets       map[string]*awstypes.Fleet
+	stacks       map[string]*awstypes.Stack
+	users        map[userKey]*awstypes.User
+	entitlements map[entitlementKey]*awstypes.Entitlement
+	// images are added with AddImage, as appstream only creates them through image builders
+	images        map[string]*awstypes.Image
+	imageBuilders map[string]*awstypes.ImageBuilder
+	// app block builders and app blocks (by arn) are added with AddAppBlockBuilder and AddAppBlock
+	appBlockBuilders map[string]*awstypes.AppBlockBuilder
+	appBlocks        map[string]*awstypes.AppBlock
+
+	// fleetStacks holds fleet-stack associations as fleet name -> stack names
+	fleetStacks map[string]map[string]struct{}
+	// userStacks holds user-stack associations as user -> stack name -> send email notification
+	userStacks map[userKey]map[string]bool
+	// applicationFleets holds application-fleet associations as application arn -> fleet names
+	applicationFleets map[string]map[string]struct{}
+	// entitledApplications holds application-entitlement associations as entitlement -> application identifiers
+	entitledApplications map[entitlementKey]map[string]struct{}
+	// appBlockBuilderAppBlocks holds app block builder-app block associations as app block builder name -> app block arns
+	appBlockBuilderAppBlocks map[string]map[string]struct{}
+
+	// tags holds tags of all resources by arn
+	tags map[string]map[string]string
+}
+
+// New returns an empty backend for DefaultRegion and DefaultAccountID.
+func New() *Backend {
+	return &Backend{
+		region:                   DefaultRegion,
+		accountID:                DefaultAccountID,
+		now:                      time.Now,
+		fleets:                   make(map[string]*awstypes.Fleet),
+		stacks:                   make(map[string]*awstypes.Stack),
+		users:                    make(map[userKey]*awstypes.User),
+		entitlements:             make(map[entitlementKey]*awstypes.Entitlement),
+		images:                   make(map[string]*awstypes.Image),
+		imageBuilders:            make(map[string]*awstypes.ImageBuilder),
+		appBlockBuilders:         make(map[string]*awstypes.AppBlockBuilder),
+		appBlocks:                make(map[string]*awstypes.AppBlock),
+		fleetStacks:              make(map[string]map[string]struct{}),
+		userStacks:               make(map[userKey]map[string]bool),
+		applicationFleets:        make(map[string]map[string]struct{}),
+		entitledApplications:     make(map[entitlementKey]map[string]struct{}),
+		appBlockBuilderAppBlocks: make(map[string]map[string]struct{}),
+		tags:                     make(map[string]map[string]string),
+	}
+}
+
+// Metadata returns provider metadata that routes all AppStream and tagging calls to the backend.
+func (b *Backend) Metadata(defaultTags map[string]string) *metadata.Metadata {
+	return &metadata.Metadata{
+		Appstream: b,
+		Tagging:   b,
+		// the backend has a single region. every region routes to it
+		AppstreamForRegion: func(string) metadata.AppStreamAPI { return b },
+		DefaultTags:        defaultTags,
+		AccountID:          DefaultAccountID,
+		Partition:          "aws",
+		Region:             DefaultRegion,
+	}
+}
+
+func (b *Backend) arn(resourceType, name string) string {
+	return fmt.Sprintf("arn:aws:appstream:%s:%s:%s/%s", b.region, b.accountID, resourceType, name)
+}
+
+func (b *Backend) createdTime() *time.Time {
+	return aws.Time(b.now().UTC().Truncate(time.Second))
+}
+
+func (b *Backend) setTags(arn string, tags map[string]string) {
+	if len(tags) == 0 {
+		return
+	}
+
+	current, ok := b.tags[arn]
+	if !ok {
+		current = make(map[string]string, len(tags))
+		b.tags[arn] = current
+	}
+
+	for k, v := range tags {
+		current[k] = v
+	}
+}
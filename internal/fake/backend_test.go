@@ -0,0 +1,410 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	awstaggingapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstaggingtypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestFleet(t *testing.T, b *Backend, name string) *awstypes.Fleet {
+	t.Helper()
+
+	out, err := b.CreateFleet(context.Background(), &awsappstream.CreateFleetInput{
+		Name:         aws.String(name),
+		InstanceType: aws.String("stream.standard.small"),
+		FleetType:    awstypes.FleetTypeOnDemand,
+		ImageName:    aws.String("image"),
+		ComputeCapacity: &awstypes.ComputeCapacity{
+			DesiredInstances: aws.Int32(1),
+		},
+	})
+	require.NoError(t, err)
+	return out.Fleet
+}
+
+func createTestStack(t *testing.T, b *Backend, name string) *awstypes.Stack {
+	t.Helper()
+
+	out, err := b.CreateStack(context.Background(), &awsappstream.CreateStackInput{
+		Name: aws.String(name),
+	})
+	require.NoError(t, err)
+	return out.Stack
+}
+
+func TestFleetLifecycle(t *testing.T) {
+	ctx := context.Background()
+	b := New()
+
+	fleet := createTestFleet(t, b, "fleet")
+	require.Equal(t, awstypes.FleetStateStopped, fleet.State)
+	require.Equal(t, "arn:aws:appstream:us-east-1:123456789012:fleet/fleet", aws.ToString(fleet.Arn))
+	require.Equal(t, "arn:aws:appstream:us-east-1::image/image", aws.ToString(fleet.ImageArn))
+	require.Equal(t, int32(1), aws.ToInt32(fleet.ComputeCapacityStatus.Desired))
+	require.Equal(t, int32(defaultMaxUserDurationInSeconds), aws.ToInt32(fleet.MaxUserDurationInSeconds))
+
+	_, err := b.CreateFleet(ctx, &awsappstream.CreateFleetInput{
+		Name:      aws.String("fleet"),
+		ImageName: aws.String("image"),
+	})
+	require.True(t, util.IsResourceAlreadyExists(err))
+
+	_, err = b.StartFleet(ctx, &awsappstream.StartFleetInput{Name: aws.String("fleet")})
+	require.NoError(t, err)
+
+	_, err = b.DeleteFleet(ctx, &awsappstream.DeleteFleetInput{Name: aws.String("fleet")})
+	require.True(t, util.IsAWSAPIError(err, "ResourceInUseException"), "running fleets cannot be deleted")
+
+	_, err = b.StopFleet(ctx, &awsappstream.StopFleetInput{Name: aws.String("fleet")})
+	require.NoError(t, err)
+
+	_, err = b.UpdateFleet(ctx, &awsappstream.UpdateFleetInput{
+		Name:               aws.String("fleet"),
+		Description:        aws.String("updated"),
+		IamRoleArn:         aws.String("arn:aws:iam::123456789012:role/role"),
+		AttributesToDelete: []awstypes.FleetAttribute{awstypes.FleetAttributeIamRoleArn},
+	})
+	require.NoError(t, err)
+
+	out, err := b.DescribeFleets(ctx, &awsappstream.DescribeFleetsInput{Names: []string{"fleet"}})
+	require.NoError(t, err)
+	require.Len(t, out.Fleets, 1)
+	require.Equal(t, "updated", aws.ToString(out.Fleets[0].Description))
+	require.Nil(t, out.Fleets[0].IamRoleArn)
+
+	_, err = b.DeleteFleet(ctx, &awsappstream.DeleteFleetInput{Name: aws.String("fleet")})
+	require.NoError(t, err)
+
+	_, err = b.DescribeFleets(ctx, &awsappstream.DescribeFleetsInput{Names: []string{"fleet"}})
+	require.True(t, util.IsAppStreamNotFound(err))
+}
+
+func TestRunningFleetUpdate(t *testing.T) {
+	ctx := context.Background()
+	b := New()
+
+	createTestFleet(t, b, "fleet")
+
+	_, err := b.StartFleet(ctx, &awsappstream.StartFleetInput{Name: aws.String("fleet")})
+	require.NoError(t, err)
+
+	// running fleets accept capacity and display name changes. unchanged values may be sent again
+	_, err = b.UpdateFleet(ctx, &awsappstream.UpdateFleetInput{
+		Name:            aws.String("fleet"),
+		DisplayName:     aws.String("display"),
+		InstanceType:    aws.String("stream.standard.small"),
+		ComputeCapacity: &awstypes.ComputeCapacity{DesiredInstances: aws.Int32(2)},
+	})
+	require.NoError(t, err)
+
+	_, err = b.UpdateFleet(ctx, &awsappstream.UpdateFleetInput{
+		Name:         aws.String("fleet"),
+		InstanceType: aws.String("stream.standard.medium"),
+	})
+	require.True(t, util.IsOperationNotPermittedException(err), "instance type changes need a stopped fleet")
+
+	out, err := b.DescribeFleets(ctx, &awsappstream.DescribeFleetsInput{Names: []string{"fleet"}})
+	require.NoError(t, err)
+	require.Equal(t, "display", aws.ToString(out.Fleets[0].DisplayName))
+	require.Equal(t, int32(2), aws.ToInt32(out.Fleets[0].ComputeCapacityStatus.Desired))
+	require.Equal(t, "stream.standard.small", aws.ToString(out.Fleets[0].InstanceType), "rejected updates change nothing")
+
+	b.SetFleetState("fleet", awstypes.FleetStateStopping)
+	_, err = b.UpdateFleet(ctx, &awsappstream.UpdateFleetInput{
+		Name:        aws.String("fleet"),
+		DisplayName: aws.String("stopping"),
+	})
+	require.True(t, util.IsOperationNotPermittedException(err), "stopping fleets cannot be updated")
+
+	b.SetFleetState("fleet", awstypes.FleetStateStopped)
+	_, err = b.UpdateFleet(ctx, &awsappstream.UpdateFleetInput{
+		Name:         aws.String("fleet"),
+		InstanceType: aws.String("stream.standard.medium"),
+	})
+	require.NoError(t, err)
+}
+
+func TestFleetStackAssociationBlocksDelete(t *testing.T) {
+	ctx := context.Background()
+	b := New()
+
+	createTestFleet(t, b, "fleet")
+	createTestStack(t, b, "stack")
+
+	_, err := b.AssociateFleet(ctx, &awsappstream.AssociateFleetInput{
+		FleetName: aws.String("fleet"),
+		StackName: aws.String("stack"),
+	})
+	require.NoError(t, err)
+
+	fleets, err := b.ListAssociatedFleets(ctx, &awsappstream.ListAssociatedFleetsInput{StackName: aws.String("stack")})
+	require.NoError(t, err)
+	require.Equal(t, []string{"fleet"}, fleets.Names)
+
+	_, err = b.DeleteStack(ctx, &awsappstream.DeleteStackInput{Name: aws.String("stack")})
+	require.True(t, util.IsAWSAPIError(err, "ResourceInUseException"))
+
+	_, err = b.DeleteFleet(ctx, &awsappstream.DeleteFleetInput{Name: aws.String("fleet")})
+	require.True(t, util.IsAWSAPIError(err, "ResourceInUseException"))
+
+	_, err = b.DisassociateFleet(ctx, &awsappstream.DisassociateFleetInput{
+		FleetName: aws.String("fleet"),
+		StackName: aws.String("stack"),
+	})
+	require.NoError(t, err)
+
+	stacks, err := b.ListAssociatedStacks(ctx, &awsappstream.ListAssociatedStacksInput{FleetName: aws.String("fleet")})
+	require.NoError(t, err)
+	require.Empty(t, stacks.Names)
+
+	_, err = b.DeleteStack(ctx, &awsappstream.DeleteStackInput{Name: aws.String("stack")})
+	require.NoError(t, err)
+}
+
+func TestListAssociatedStacksPagination(t *testing.T) {
+	ctx := context.Background()
+	b := New()
+
+	createTestFleet(t, b, "fleet")
+	for _, name := range []string{"a", "b", "c"} {
+		createTestStack(t, b, name)
+		_, err := b.AssociateFleet(ctx, &awsappstream.AssociateFleetInput{
+			FleetName: aws.String("fleet"),
+			StackName: aws.String(name),
+		})
+		require.NoError(t, err)
+	}
+
+	first, err := b.ListAssociatedStacks(ctx, &awsappstream.ListAssociatedStacksInput{FleetName: aws.String("fleet")})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, first.Names)
+	require.NotNil(t, first.NextToken)
+
+	second, err := b.ListAssociatedStacks(ctx, &awsappstream.ListAssociatedStacksInput{
+		FleetName: aws.String("fleet"),
+		NextToken: first.NextToken,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c"}, second.Names)
+	require.Nil(t, second.NextToken)
+}
+
+func TestUserStackAssociation(t *testing.T) {
+	ctx := context.Background()
+	b := New()
+
+	createTestStack(t, b, "stack")
+
+	association := awstypes.UserStackAssociation{
+		AuthenticationType: awstypes.AuthenticationTypeUserpool,
+		StackName:          aws.String("stack"),
+		UserName:           aws.String("user@example.com"),
+	}
+
+	out, err := b.BatchAssociateUserStack(ctx, &awsappstream.BatchAssociateUserStackInput{
+		UserStackAssociations: []awstypes.UserStackAssociation{association},
+	})
+	require.NoError(t, err)
+	require.Len(t, out.Errors, 1)
+	require.Equal(t, awstypes.UserStackAssociationErrorCodeUserNameNotFound, out.Errors[0].ErrorCode)
+
+	_, err = b.CreateUser(ctx, &awsappstream.CreateUserInput{
+		AuthenticationType: awstypes.AuthenticationTypeUserpool,
+		UserName:           aws.String("user@example.com"),
+	})
+	require.NoError(t, err)
+
+	out, err = b.BatchAssociateUserStack(ctx, &awsappstream.BatchAssociateUserStackInput{
+		UserStackAssociations: []awstypes.UserStackAssociation{association},
+	})
+	require.NoError(t, err)
+	require.Empty(t, out.Errors)
+
+	associations, err := b.DescribeUserStackAssociations(ctx, &awsappstream.DescribeUserStackAssociationsInput{
+		AuthenticationType: awstypes.AuthenticationTypeUserpool,
+		StackName:          aws.String("stack"),
+		UserName:           aws.String("user@example.com"),
+	})
+	require.NoError(t, err)
+	require.Len(t, associations.UserStackAssociations, 1)
+
+	_, err = b.DeleteUser(ctx, &awsappstream.DeleteUserInput{
+		AuthenticationType: awstypes.AuthenticationTypeUserpool,
+		UserName:           aws.String("user@example.com"),
+	})
+	require.NoError(t, err)
+
+	associations, err = b.DescribeUserStackAssociations(ctx, &awsappstream.DescribeUserStackAssociationsInput{
+		StackName: aws.String("stack"),
+	})
+	require.NoError(t, err)
+	require.Empty(t, associations.UserStackAssociations)
+}
+
+func TestEntitlementNotFound(t *testing.T) {
+	ctx := context.Background()
+	b := New()
+
+	createTestStack(t, b, "stack")
+
+	_, err := b.DescribeEntitlements(ctx, &awsappstream.DescribeEntitlementsInput{
+		StackName: aws.String("stack"),
+		Name:      aws.String("missing"),
+	})
+	require.True(t, util.IsAppStreamNotFound(err))
+
+	_, err = b.CreateEntitlement(ctx, &awsappstream.CreateEntitlementInput{
+		StackName:     aws.String("stack"),
+		Name:          aws.String("entitlement"),
+		AppVisibility: awstypes.AppVisibilityAll,
+	})
+	require.NoError(t, err)
+
+	_, err = b.CreateEntitlement(ctx, &awsappstream.CreateEntitlementInput{
+		StackName: aws.String("stack"),
+		Name:      aws.String("entitlement"),
+	})
+	require.True(t, util.IsEntitlementAlreadyExists(err))
+}
+
+func TestTagging(t *testing.T) {
+	ctx := context.Background()
+	b := New()
+
+	stack := createTestStack(t, b, "stack")
+	arn := aws.ToString(stack.Arn)
+
+	_, err := b.TagResources(ctx, &awstaggingapi.TagResourcesInput{
+		ResourceARNList: []string{arn},
+		Tags:            map[string]string{"a": "1", "b": "2"},
+	})
+	require.NoError(t, err)
+
+	_, err = b.UntagResources(ctx, &awstaggingapi.UntagResourcesInput{
+		ResourceARNList: []string{arn},
+		TagKeys:         []string{"a"},
+	})
+	require.NoError(t, err)
+
+	out, err := b.GetResources(ctx, &awstaggingapi.GetResourcesInput{ResourceARNList: []string{arn}})
+	require.NoError(t, err)
+	require.Len(t, out.ResourceTagMappingList, 1)
+	require.Equal(t, map[string]string{"b": "2"}, b.Tags(arn))
+
+	out, err = b.GetResources(ctx, &awstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{"appstream:stack"},
+		TagFilters:          []awstaggingtypes.TagFilter{{Key: aws.String("b"), Values: []string{"2"}}},
+	})
+	require.NoError(t, err)
+	require.Len(t, out.ResourceTagMappingList, 1)
+	require.Equal(t, arn, aws.ToString(out.ResourceTagMappingList[0].ResourceARN))
+
+	out, err = b.GetResources(ctx, &awstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{"appstream:fleet"},
+	})
+	require.NoError(t, err)
+	require.Empty(t, out.ResourceTagMappingList)
+
+	_, err = b.DeleteStack(ctx, &awsappstream.DeleteStackInput{Name: aws.String("stack")})
+	require.NoError(t, err)
+	require.Empty(t, b.Tags(arn))
+}
+
+func TestImageAndImageBuilder(t *testing.T) {
+	ctx := context.Background()
+	b := New()
+
+	image := b.AddImage("image")
+	require.Equal(t, "arn:aws:appstream:us-east-1::image/image", aws.ToString(image.Arn))
+	require.Equal(t, awstypes.ImageStateAvailable, image.State)
+
+	images, err := b.DescribeImages(ctx, &awsappstream.DescribeImagesInput{Arns: []string{aws.ToString(image.Arn)}})
+	require.NoError(t, err)
+	require.Len(t, images.Images, 1)
+
+	out, err := b.CreateImageBuilder(ctx, &awsappstream.CreateImageBuilderInput{
+		Name:         aws.String("builder"),
+		InstanceType: aws.String("stream.standard.small"),
+		ImageName:    aws.String("image"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, awstypes.ImageBuilderStateRunning, out.ImageBuilder.State)
+	require.Equal(t, image.Arn, out.ImageBuilder.ImageArn)
+
+	_, err = b.DeleteImageBuilder(ctx, &awsappstream.DeleteImageBuilderInput{Name: aws.String("builder")})
+	require.True(t, util.IsOperationNotPermittedException(err))
+
+	_, err = b.StopImageBuilder(ctx, &awsappstream.StopImageBuilderInput{Name: aws.String("builder")})
+	require.NoError(t, err)
+	_, err = b.DeleteImageBuilder(ctx, &awsappstream.DeleteImageBuilderInput{Name: aws.String("builder")})
+	require.NoError(t, err)
+
+	_, err = b.DescribeImageBuilders(ctx, &awsappstream.DescribeImageBuildersInput{Names: []string{"builder"}})
+	require.True(t, util.IsResourceNotFoundException(err))
+
+	_, err = b.DeleteImage(ctx, &awsappstream.DeleteImageInput{Name: aws.String("image")})
+	require.NoError(t, err)
+
+	_, err = b.DescribeImages(ctx, &awsappstream.DescribeImagesInput{Names: []string{"image"}})
+	require.True(t, util.IsResourceNotFoundException(err))
+}
+
+func TestAppBlockBuilderAppBlockAssociation(t *testing.T) {
+	ctx := context.Background()
+	b := New()
+
+	b.AddAppBlockBuilder("builder")
+	appBlock := b.AddAppBlock("app-block")
+
+	_, err := b.AssociateAppBlockBuilderAppBlock(ctx, &awsappstream.AssociateAppBlockBuilderAppBlockInput{
+		AppBlockBuilderName: aws.String("missing"),
+		AppBlockArn:         appBlock.Arn,
+	})
+	require.True(t, util.IsResourceNotFoundException(err))
+
+	_, err = b.AssociateAppBlockBuilderAppBlock(ctx, &awsappstream.AssociateAppBlockBuilderAppBlockInput{
+		AppBlockBuilderName: aws.String("builder"),
+		AppBlockArn:         appBlock.Arn,
+	})
+	require.NoError(t, err)
+
+	out, err := b.DescribeAppBlockBuilderAppBlockAssociations(ctx, &awsappstream.DescribeAppBlockBuilderAppBlockAssociationsInput{
+		AppBlockArn: appBlock.Arn,
+	})
+	require.NoError(t, err)
+	require.Len(t, out.AppBlockBuilderAppBlockAssociations, 1)
+	require.Equal(t, "builder", aws.ToString(out.AppBlockBuilderAppBlockAssociations[0].AppBlockBuilderName))
+
+	_, err = b.DisassociateAppBlockBuilderAppBlock(ctx, &awsappstream.DisassociateAppBlockBuilderAppBlockInput{
+		AppBlockBuilderName: aws.String("builder"),
+		AppBlockArn:         appBlock.Arn,
+	})
+	require.NoError(t, err)
+
+	_, err = b.DisassociateAppBlockBuilderAppBlock(ctx, &awsappstream.DisassociateAppBlockBuilderAppBlockInput{
+		AppBlockBuilderName: aws.String("builder"),
+		AppBlockArn:         appBlock.Arn,
+	})
+	require.True(t, util.IsResourceNotFoundException(err))
+
+	out, err = b.DescribeAppBlockBuilderAppBlockAssociations(ctx, &awsappstream.DescribeAppBlockBuilderAppBlockAssociationsInput{
+		AppBlockBuilderName: aws.String("builder"),
+	})
+	require.NoError(t, err)
+	require.Empty(t, out.AppBlockBuilderAppBlockAssociations)
+}
+
+func TestUnsupportedOperation(t *testing.T) {
+	_, err := New().DescribeAppBlocks(context.Background(), &awsappstream.DescribeAppBlocksInput{})
+	require.ErrorContains(t, err, "DescribeAppBlocks is not supported")
+}
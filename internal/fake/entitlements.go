@@ -0,0 +1,124 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+)
+
+type entitlementKey struct {
+	stackName string
+	name      string
+}
+
+func (b *Backend) CreateEntitlement(
+	_ context.Context, params *awsappstream.CreateEntitlementInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.CreateEntitlementOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := entitlementKey{aws.ToString(params.StackName), aws.ToString(params.Name)}
+	if _, ok := b.stacks[key.stackName]; !ok {
+		return nil, errResourceNotFound("stack %s not found", key.stackName)
+	}
+	if _, ok := b.entitlements[key]; ok {
+		return nil, errEntitlementAlreadyExists("entitlement %s already exists in stack %s", key.name, key.stackName)
+	}
+
+	now := b.createdTime()
+	entitlement := &awstypes.Entitlement{
+		Name:             aws.String(key.name),
+		StackName:        aws.String(key.stackName),
+		AppVisibility:    params.AppVisibility,
+		Attributes:       params.Attributes,
+		Description:      params.Description,
+		CreatedTime:      now,
+		LastModifiedTime: now,
+	}
+	b.entitlements[key] = entitlement
+
+	return &awsappstream.CreateEntitlementOutput{Entitlement: copyEntitlement(entitlement)}, nil
+}
+
+func (b *Backend) DescribeEntitlements(
+	_ context.Context, params *awsappstream.DescribeEntitlementsInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DescribeEntitlementsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stackName := aws.ToString(params.StackName)
+	if _, ok := b.stacks[stackName]; !ok {
+		return nil, errResourceNotFound("stack %s not found", stackName)
+	}
+
+	out := &awsappstream.DescribeEntitlementsOutput{}
+
+	if params.Name != nil {
+		entitlement, ok := b.entitlements[entitlementKey{stackName, aws.ToString(params.Name)}]
+		if !ok {
+			return nil, errEntitlementNotFound("entitlement %s not found in stack %s", aws.ToString(params.Name), stackName)
+		}
+		out.Entitlements = append(out.Entitlements, *copyEntitlement(entitlement))
+		return out, nil
+	}
+
+	for key, entitlement := range b.entitlements {
+		if key.stackName == stackName {
+			out.Entitlements = append(out.Entitlements, *copyEntitlement(entitlement))
+		}
+	}
+
+	return out, nil
+}
+
+func (b *Backend) UpdateEntitlement(
+	_ context.Context, params *awsappstream.UpdateEntitlementInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.UpdateEntitlementOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := entitlementKey{aws.ToString(params.StackName), aws.ToString(params.Name)}
+	entitlement, ok := b.entitlements[key]
+	if !ok {
+		return nil, errEntitlementNotFound("entitlement %s not found in stack %s", key.name, key.stackName)
+	}
+
+	setIfNotNil(&entitlement.Description, params.Description)
+	if params.AppVisibility != "" {
+		entitlement.AppVisibility = params.AppVisibility
+	}
+	if params.Attributes != nil {
+		entitlement.Attributes = params.Attributes
+	}
+	entitlement.LastModifiedTime = b.createdTime()
+
+	return &awsappstream.UpdateEntitlementOutput{Entitlement: copyEntitlement(entitlement)}, nil
+}
+
+func (b *Backend) DeleteEntitlement(
+	_ context.Context, params *awsappstream.DeleteEntitlementInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DeleteEntitlementOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := entitlementKey{aws.ToString(params.StackName), aws.ToString(params.Name)}
+	if _, ok := b.entitlements[key]; !ok {
+		return nil, errEntitlementNotFound("entitlement %s not found in stack %s", key.name, key.stackName)
+	}
+
+	delete(b.entitlements, key)
+	delete(b.entitledApplications, key)
+
+	return &awsappstream.DeleteEntitlementOutput{}, nil
+}
+
+func copyEntitlement(entitlement *awstypes.Entitlement) *awstypes.Entitlement {
+	out := *entitlement
+	out.Attributes = append([]awstypes.EntitlementAttribute(nil), entitlement.Attributes...)
+	return &out
+}
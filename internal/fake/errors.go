@@ -0,0 +1,44 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+)
+
+func errResourceNotFound(format string, args ...any) error {
+	return &awstypes.ResourceNotFoundException{Message: aws.String(fmt.Sprintf(format, args...))}
+}
+
+func errResourceAlreadyExists(format string, args ...any) error {
+	return &awstypes.ResourceAlreadyExistsException{Message: aws.String(fmt.Sprintf(format, args...))}
+}
+
+func errResourceInUse(format string, args ...any) error {
+	return &awstypes.ResourceInUseException{Message: aws.String(fmt.Sprintf(format, args...))}
+}
+
+func errOperationNotPermitted(format string, args ...any) error {
+	return &awstypes.OperationNotPermittedException{Message: aws.String(fmt.Sprintf(format, args...))}
+}
+
+func errInvalidParameterCombination(format string, args ...any) error {
+	return &awstypes.InvalidParameterCombinationException{Message: aws.String(fmt.Sprintf(format, args...))}
+}
+
+func errEntitlementNotFound(format string, args ...any) error {
+	return &awstypes.EntitlementNotFoundException{Message: aws.String(fmt.Sprintf(format, args...))}
+}
+
+func errEntitlementAlreadyExists(format string, args ...any) error {
+	return &awstypes.EntitlementAlreadyExistsException{Message: aws.String(fmt.Sprintf(format, args...))}
+}
+
+// errNotSupported is returned for AppStream operations the backend does not model.
+func errNotSupported(operation string) error {
+	return fmt.Errorf("fake appstream backend: operation %s is not supported", operation)
+}
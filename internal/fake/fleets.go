@@ -0,0 +1,334 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+)
+
+const (
+	defaultMaxUserDurationInSeconds       = 57600
+	defaultDisconnectTimeoutInSeconds     = 900
+	defaultIdleDisconnectTimeoutInSeconds = 0
+)
+
+func (b *Backend) CreateFleet(
+	_ context.Context, params *awsappstream.CreateFleetInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.CreateFleetOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.Name)
+	if _, ok := b.fleets[name]; ok {
+		return nil, errResourceAlreadyExists("fleet %s already exists", name)
+	}
+
+	if (params.ImageName == nil) == (params.ImageArn == nil) {
+		return nil, errInvalidParameterCombination("exactly one of ImageName or ImageArn must be specified")
+	}
+
+	fleet := &awstypes.Fleet{
+		Arn:                            aws.String(b.arn("fleet", name)),
+		Name:                           aws.String(name),
+		InstanceType:                   params.InstanceType,
+		State:                          awstypes.FleetStateStopped,
+		CreatedTime:                    b.createdTime(),
+		Description:                    params.Description,
+		DisplayName:                    params.DisplayName,
+		DomainJoinInfo:                 params.DomainJoinInfo,
+		EnableDefaultInternetAccess:    aws.Bool(aws.ToBool(params.EnableDefaultInternetAccess)),
+		FleetType:                      params.FleetType,
+		IamRoleArn:                     params.IamRoleArn,
+		MaxConcurrentSessions:          params.MaxConcurrentSessions,
+		MaxSessionsPerInstance:         params.MaxSessionsPerInstance,
+		MaxUserDurationInSeconds:       int32OrDefault(params.MaxUserDurationInSeconds, defaultMaxUserDurationInSeconds),
+		DisconnectTimeoutInSeconds:     int32OrDefault(params.DisconnectTimeoutInSeconds, defaultDisconnectTimeoutInSeconds),
+		IdleDisconnectTimeoutInSeconds: int32OrDefault(params.IdleDisconnectTimeoutInSeconds, defaultIdleDisconnectTimeoutInSeconds),
+		Platform:                       params.Platform,
+		RootVolumeConfig:               params.RootVolumeConfig,
+		SessionScriptS3Location:        params.SessionScriptS3Location,
+		StreamView:                     params.StreamView,
+		UsbDeviceFilterStrings:         params.UsbDeviceFilterStrings,
+		VpcConfig:                      params.VpcConfig,
+	}
+
+	if fleet.FleetType == "" {
+		fleet.FleetType = awstypes.FleetTypeOnDemand
+	}
+	if fleet.StreamView == "" {
+		fleet.StreamView = awstypes.StreamViewApp
+	}
+	if fleet.Platform == "" {
+		fleet.Platform = awstypes.PlatformTypeWindowsServer2019
+	}
+
+	b.setFleetImage(fleet, params.ImageName, params.ImageArn)
+	setFleetComputeCapacity(fleet, params.ComputeCapacity)
+
+	b.fleets[name] = fleet
+	b.setTags(aws.ToString(fleet.Arn), params.Tags)
+
+	return &awsappstream.CreateFleetOutput{Fleet: copyFleet(fleet)}, nil
+}
+
+func (b *Backend) DescribeFleets(
+	_ context.Context, params *awsappstream.DescribeFleetsInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DescribeFleetsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := &awsappstream.DescribeFleetsOutput{}
+
+	if len(params.Names) == 0 {
+		for _, name := range sortedKeys(b.fleets) {
+			out.Fleets = append(out.Fleets, *copyFleet(b.fleets[name]))
+		}
+		return out, nil
+	}
+
+	for _, name := range params.Names {
+		fleet, ok := b.fleets[name]
+		if !ok {
+			return nil, errResourceNotFound("fleet %s not found", name)
+		}
+		out.Fleets = append(out.Fleets, *copyFleet(fleet))
+	}
+
+	return out, nil
+}
+
+func (b *Backend) UpdateFleet(
+	_ context.Context, params *awsappstream.UpdateFleetInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.UpdateFleetOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.Name)
+	current, ok := b.fleets[name]
+	if !ok {
+		return nil, errResourceNotFound("fleet %s not found", name)
+	}
+
+	if current.State == awstypes.FleetStateStarting || current.State == awstypes.FleetStateStopping {
+		return nil, errOperationNotPermitted("fleet %s cannot be updated in state %s", name, current.State)
+	}
+
+	// apply the update to a copy so that a rejected update leaves the fleet unchanged
+	fleet := copyFleet(current)
+
+	if params.ImageName != nil || params.ImageArn != nil {
+		b.setFleetImage(fleet, params.ImageName, params.ImageArn)
+	}
+	if params.ComputeCapacity != nil {
+		setFleetComputeCapacity(fleet, params.ComputeCapacity)
+	}
+
+	setIfNotNil(&fleet.InstanceType, params.InstanceType)
+	setIfNotNil(&fleet.Description, params.Description)
+	setIfNotNil(&fleet.DisplayName, params.DisplayName)
+	setIfNotNil(&fleet.DomainJoinInfo, params.DomainJoinInfo)
+	setIfNotNil(&fleet.EnableDefaultInternetAccess, params.EnableDefaultInternetAccess)
+	setIfNotNil(&fleet.IamRoleArn, params.IamRoleArn)
+	setIfNotNil(&fleet.MaxConcurrentSessions, params.MaxConcurrentSessions)
+	setIfNotNil(&fleet.MaxSessionsPerInstance, params.MaxSessionsPerInstance)
+	setIfNotNil(&fleet.MaxUserDurationInSeconds, params.MaxUserDurationInSeconds)
+	setIfNotNil(&fleet.DisconnectTimeoutInSeconds, params.DisconnectTimeoutInSeconds)
+	setIfNotNil(&fleet.IdleDisconnectTimeoutInSeconds, params.IdleDisconnectTimeoutInSeconds)
+	setIfNotNil(&fleet.RootVolumeConfig, params.RootVolumeConfig)
+	setIfNotNil(&fleet.SessionScriptS3Location, params.SessionScriptS3Location)
+	setIfNotNil(&fleet.VpcConfig, params.VpcConfig)
+
+	if params.Platform != "" {
+		fleet.Platform = params.Platform
+	}
+	if params.StreamView != "" {
+		fleet.StreamView = params.StreamView
+	}
+	if params.UsbDeviceFilterStrings != nil {
+		fleet.UsbDeviceFilterStrings = params.UsbDeviceFilterStrings
+	}
+	if aws.ToBool(params.DeleteVpcConfig) {
+		fleet.VpcConfig = nil
+	}
+
+	for _, attr := range params.AttributesToDelete {
+		switch attr {
+		case awstypes.FleetAttributeVpcConfiguration:
+			fleet.VpcConfig = nil
+		case awstypes.FleetAttributeVpcConfigurationSecurityGroupIds:
+			if fleet.VpcConfig != nil {
+				fleet.VpcConfig = &awstypes.VpcConfig{SubnetIds: fleet.VpcConfig.SubnetIds}
+			}
+		case awstypes.FleetAttributeDomainJoinInfo:
+			fleet.DomainJoinInfo = nil
+		case awstypes.FleetAttributeIamRoleArn:
+			fleet.IamRoleArn = nil
+		case awstypes.FleetAttributeUsbDeviceFilterStrings:
+			fleet.UsbDeviceFilterStrings = nil
+		case awstypes.FleetAttributeSessionScriptS3Location:
+			fleet.SessionScriptS3Location = nil
+		case awstypes.FleetAttributeMaxSessionsPerInstance:
+			fleet.MaxSessionsPerInstance = nil
+		case awstypes.FleetAttributeVolumeConfiguration:
+			fleet.RootVolumeConfig = nil
+		}
+	}
+
+	if current.State == awstypes.FleetStateRunning && !runningFleetUpdatePermitted(current, fleet) {
+		return nil, errOperationNotPermitted("fleet %s must be stopped before this update", name)
+	}
+
+	b.fleets[name] = fleet
+
+	return &awsappstream.UpdateFleetOutput{Fleet: copyFleet(fleet)}, nil
+}
+
+// runningFleetUpdatePermitted reports whether updated only differs from current in attributes
+// aws accepts on running fleets.
+// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_UpdateFleet.html
+func runningFleetUpdatePermitted(current, updated *awstypes.Fleet) bool {
+	restricted := func(fleet *awstypes.Fleet) awstypes.Fleet {
+		out := *copyFleet(fleet)
+		out.DisplayName = nil
+		out.ComputeCapacityStatus = nil
+		out.DisconnectTimeoutInSeconds = nil
+		out.IdleDisconnectTimeoutInSeconds = nil
+
+		if fleet.FleetType == awstypes.FleetTypeElastic {
+			out.MaxConcurrentSessions = nil
+			out.SessionScriptS3Location = nil
+			out.UsbDeviceFilterStrings = nil
+		} else {
+			out.ImageName = nil
+			out.ImageArn = nil
+		}
+		return out
+	}
+
+	return reflect.DeepEqual(restricted(current), restricted(updated))
+}
+
+func (b *Backend) DeleteFleet(
+	_ context.Context, params *awsappstream.DeleteFleetInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DeleteFleetOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.Name)
+	fleet, ok := b.fleets[name]
+	if !ok {
+		return nil, errResourceNotFound("fleet %s not found", name)
+	}
+
+	if fleet.State != awstypes.FleetStateStopped {
+		return nil, errResourceInUse("fleet %s must be stopped before deletion", name)
+	}
+
+	if len(b.fleetStacks[name]) > 0 {
+		return nil, errResourceInUse("fleet %s is associated with stacks", name)
+	}
+
+	delete(b.fleets, name)
+	delete(b.fleetStacks, name)
+	delete(b.tags, aws.ToString(fleet.Arn))
+
+	return &awsappstream.DeleteFleetOutput{}, nil
+}
+
+func (b *Backend) StartFleet(
+	_ context.Context, params *awsappstream.StartFleetInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.StartFleetOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.Name)
+	fleet, ok := b.fleets[name]
+	if !ok {
+		return nil, errResourceNotFound("fleet %s not found", name)
+	}
+
+	if fleet.State != awstypes.FleetStateStopped && fleet.State != awstypes.FleetStateRunning {
+		return nil, errOperationNotPermitted("fleet %s cannot be started in state %s", name, fleet.State)
+	}
+
+	fleet.State = awstypes.FleetStateRunning
+
+	return &awsappstream.StartFleetOutput{}, nil
+}
+
+func (b *Backend) StopFleet(
+	_ context.Context, params *awsappstream.StopFleetInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.StopFleetOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.Name)
+	fleet, ok := b.fleets[name]
+	if !ok {
+		return nil, errResourceNotFound("fleet %s not found", name)
+	}
+
+	if fleet.State != awstypes.FleetStateStopped && fleet.State != awstypes.FleetStateRunning {
+		return nil, errOperationNotPermitted("fleet %s cannot be stopped in state %s", name, fleet.State)
+	}
+
+	fleet.State = awstypes.FleetStateStopped
+
+	return &awsappstream.StopFleetOutput{}, nil
+}
+
+// SetFleetState overrides the state of a fleet, for example to simulate a fleet
+// stuck in STARTING or stopped outside terraform.
+func (b *Backend) SetFleetState(name string, state awstypes.FleetState) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if fleet, ok := b.fleets[name]; ok {
+		fleet.State = state
+	}
+}
+
+func (b *Backend) setFleetImage(fleet *awstypes.Fleet, imageName, imageARN *string) {
+	switch {
+	case imageName != nil:
+		fleet.ImageName = imageName
+		fleet.ImageArn = aws.String(b.imageARN(aws.ToString(imageName)))
+	case imageARN != nil:
+		fleet.ImageArn = imageARN
+		fleet.ImageName = aws.String(imageNameFromARN(aws.ToString(imageARN)))
+	}
+}
+
+func (b *Backend) imageARN(name string) string {
+	return "arn:aws:appstream:" + b.region + "::image/" + name
+}
+
+func imageNameFromARN(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+func setFleetComputeCapacity(fleet *awstypes.Fleet, capacity *awstypes.ComputeCapacity) {
+	if capacity == nil {
+		fleet.ComputeCapacityStatus = nil
+		return
+	}
+
+	fleet.ComputeCapacityStatus = &awstypes.ComputeCapacityStatus{
+		Desired:             capacity.DesiredInstances,
+		DesiredUserSessions: capacity.DesiredSessions,
+	}
+}
+
+func copyFleet(fleet *awstypes.Fleet) *awstypes.Fleet {
+	out := *fleet
+	out.UsbDeviceFilterStrings = append([]string(nil), fleet.UsbDeviceFilterStrings...)
+	out.FleetErrors = append([]awstypes.FleetError(nil), fleet.FleetErrors...)
+	return &out
+}
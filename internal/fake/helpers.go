@@ -0,0 +1,57 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func int32OrDefault(v *int32, def int32) *int32 {
+	if v == nil {
+		return aws.Int32(def)
+	}
+	return v
+}
+
+func setIfNotNil[T any](dst **T, src *T) {
+	if src != nil {
+		*dst = src
+	}
+}
+
+func sortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// listPageSize is small so that tests exercise the pagination of list operations.
+const listPageSize = 2
+
+// page returns the page of items that starts at nextToken and the token of the following page.
+func page[T any](items []T, nextToken *string) ([]T, *string, error) {
+	start := 0
+	if nextToken != nil {
+		var err error
+		start, err = strconv.Atoi(*nextToken)
+		if err != nil || start < 0 || start > len(items) {
+			return nil, nil, fmt.Errorf("invalid next token %q", *nextToken)
+		}
+	}
+
+	end := min(start+listPageSize, len(items))
+	if end == len(items) {
+		return items[start:end], nil, nil
+	}
+
+	return items[start:end], aws.String(strconv.Itoa(end)), nil
+}
@@ -0,0 +1,223 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+)
+
+// AddImage adds an available private image, as if an image builder had created it.
+// AppStream has no api to create images directly.
+func (b *Backend) AddImage(name string) *awstypes.Image {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	image := &awstypes.Image{
+		Arn:         aws.String(b.imageARN(name)),
+		Name:        aws.String(name),
+		State:       awstypes.ImageStateAvailable,
+		Visibility:  awstypes.VisibilityTypePrivate,
+		Platform:    awstypes.PlatformTypeWindowsServer2019,
+		CreatedTime: b.createdTime(),
+	}
+	b.images[name] = image
+
+	return copyImage(image)
+}
+
+func (b *Backend) DescribeImages(
+	_ context.Context, params *awsappstream.DescribeImagesInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DescribeImagesOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := &awsappstream.DescribeImagesOutput{}
+
+	if len(params.Names) == 0 && len(params.Arns) == 0 {
+		for _, name := range sortedKeys(b.images) {
+			image := b.images[name]
+			if params.Type != "" && image.Visibility != params.Type {
+				continue
+			}
+			out.Images = append(out.Images, *copyImage(image))
+		}
+		return out, nil
+	}
+
+	for _, name := range params.Names {
+		image, ok := b.images[name]
+		if !ok {
+			return nil, errResourceNotFound("image %s not found", name)
+		}
+		out.Images = append(out.Images, *copyImage(image))
+	}
+
+	for _, arn := range params.Arns {
+		image, ok := b.images[imageNameFromARN(arn)]
+		if !ok || aws.ToString(image.Arn) != arn {
+			return nil, errResourceNotFound("image %s not found", arn)
+		}
+		out.Images = append(out.Images, *copyImage(image))
+	}
+
+	return out, nil
+}
+
+func (b *Backend) DeleteImage(
+	_ context.Context, params *awsappstream.DeleteImageInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DeleteImageOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.Name)
+	image, ok := b.images[name]
+	if !ok {
+		return nil, errResourceNotFound("image %s not found", name)
+	}
+
+	delete(b.images, name)
+	delete(b.tags, aws.ToString(image.Arn))
+
+	out := copyImage(image)
+	out.State = awstypes.ImageStateDeleting
+
+	return &awsappstream.DeleteImageOutput{Image: out}, nil
+}
+
+func (b *Backend) CreateImageBuilder(
+	_ context.Context, params *awsappstream.CreateImageBuilderInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.CreateImageBuilderOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.Name)
+	if _, ok := b.imageBuilders[name]; ok {
+		return nil, errResourceAlreadyExists("image builder %s already exists", name)
+	}
+
+	if (params.ImageName == nil) == (params.ImageArn == nil) {
+		return nil, errInvalidParameterCombination("exactly one of ImageName or ImageArn must be specified")
+	}
+
+	imageARN := aws.ToString(params.ImageArn)
+	if params.ImageName != nil {
+		imageARN = b.imageARN(aws.ToString(params.ImageName))
+	}
+
+	// new image builders start on their own
+	imageBuilder := &awstypes.ImageBuilder{
+		Arn:                         aws.String(b.arn("image-builder", name)),
+		Name:                        aws.String(name),
+		ImageArn:                    aws.String(imageARN),
+		InstanceType:                params.InstanceType,
+		State:                       awstypes.ImageBuilderStateRunning,
+		CreatedTime:                 b.createdTime(),
+		Description:                 params.Description,
+		DisplayName:                 params.DisplayName,
+		EnableDefaultInternetAccess: aws.Bool(aws.ToBool(params.EnableDefaultInternetAccess)),
+		IamRoleArn:                  params.IamRoleArn,
+		Platform:                    awstypes.PlatformTypeWindowsServer2019,
+		VpcConfig:                   params.VpcConfig,
+	}
+
+	b.imageBuilders[name] = imageBuilder
+	b.setTags(aws.ToString(imageBuilder.Arn), params.Tags)
+
+	out := *imageBuilder
+	return &awsappstream.CreateImageBuilderOutput{ImageBuilder: &out}, nil
+}
+
+func (b *Backend) DescribeImageBuilders(
+	_ context.Context, params *awsappstream.DescribeImageBuildersInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DescribeImageBuildersOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := &awsappstream.DescribeImageBuildersOutput{}
+
+	if len(params.Names) == 0 {
+		for _, name := range sortedKeys(b.imageBuilders) {
+			out.ImageBuilders = append(out.ImageBuilders, *b.imageBuilders[name])
+		}
+		return out, nil
+	}
+
+	for _, name := range params.Names {
+		imageBuilder, ok := b.imageBuilders[name]
+		if !ok {
+			return nil, errResourceNotFound("image builder %s not found", name)
+		}
+		out.ImageBuilders = append(out.ImageBuilders, *imageBuilder)
+	}
+
+	return out, nil
+}
+
+func (b *Backend) DeleteImageBuilder(
+	_ context.Context, params *awsappstream.DeleteImageBuilderInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DeleteImageBuilderOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.Name)
+	imageBuilder, ok := b.imageBuilders[name]
+	if !ok {
+		return nil, errResourceNotFound("image builder %s not found", name)
+	}
+
+	if imageBuilder.State != awstypes.ImageBuilderStateStopped {
+		return nil, errOperationNotPermitted("image builder %s must be stopped before deletion", name)
+	}
+
+	delete(b.imageBuilders, name)
+	delete(b.tags, aws.ToString(imageBuilder.Arn))
+
+	return &awsappstream.DeleteImageBuilderOutput{}, nil
+}
+
+func (b *Backend) StartImageBuilder(
+	_ context.Context, params *awsappstream.StartImageBuilderInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.StartImageBuilderOutput, error) {
+	return b.setImageBuilderState(aws.ToString(params.Name), awstypes.ImageBuilderStateRunning)
+}
+
+func (b *Backend) StopImageBuilder(
+	_ context.Context, params *awsappstream.StopImageBuilderInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.StopImageBuilderOutput, error) {
+	if _, err := b.setImageBuilderState(aws.ToString(params.Name), awstypes.ImageBuilderStateStopped); err != nil {
+		return nil, err
+	}
+	return &awsappstream.StopImageBuilderOutput{}, nil
+}
+
+func (b *Backend) setImageBuilderState(
+	name string, state awstypes.ImageBuilderState,
+) (*awsappstream.StartImageBuilderOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	imageBuilder, ok := b.imageBuilders[name]
+	if !ok {
+		return nil, errResourceNotFound("image builder %s not found", name)
+	}
+
+	if imageBuilder.State != awstypes.ImageBuilderStateStopped && imageBuilder.State != awstypes.ImageBuilderStateRunning {
+		return nil, errOperationNotPermitted("image builder %s cannot change state in state %s", name, imageBuilder.State)
+	}
+
+	imageBuilder.State = state
+
+	return &awsappstream.StartImageBuilderOutput{}, nil
+}
+
+func copyImage(image *awstypes.Image) *awstypes.Image {
+	out := *image
+	out.Applications = append([]awstypes.Application(nil), image.Applications...)
+	out.ImageErrors = append([]awstypes.ResourceError(nil), image.ImageErrors...)
+	return &out
+}
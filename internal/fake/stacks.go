@@ -0,0 +1,209 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+)
+
+func (b *Backend) CreateStack(
+	_ context.Context, params *awsappstream.CreateStackInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.CreateStackOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.Name)
+	if _, ok := b.stacks[name]; ok {
+		return nil, errResourceAlreadyExists("stack %s already exists", name)
+	}
+
+	stack := &awstypes.Stack{
+		Arn:                         aws.String(b.arn("stack", name)),
+		Name:                        aws.String(name),
+		CreatedTime:                 b.createdTime(),
+		Description:                 params.Description,
+		DisplayName:                 params.DisplayName,
+		AccessEndpoints:             params.AccessEndpoints,
+		EmbedHostDomains:            params.EmbedHostDomains,
+		FeedbackURL:                 params.FeedbackURL,
+		RedirectURL:                 params.RedirectURL,
+		StorageConnectors:           params.StorageConnectors,
+		StreamingExperienceSettings: params.StreamingExperienceSettings,
+		UserSettings:                params.UserSettings,
+	}
+	b.setStackApplicationSettings(stack, params.ApplicationSettings)
+
+	b.stacks[name] = stack
+	b.setTags(aws.ToString(stack.Arn), params.Tags)
+
+	return &awsappstream.CreateStackOutput{Stack: copyStack(stack)}, nil
+}
+
+func (b *Backend) DescribeStacks(
+	_ context.Context, params *awsappstream.DescribeStacksInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DescribeStacksOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := &awsappstream.DescribeStacksOutput{}
+
+	if len(params.Names) == 0 {
+		for _, name := range sortedKeys(b.stacks) {
+			out.Stacks = append(out.Stacks, *copyStack(b.stacks[name]))
+		}
+		return out, nil
+	}
+
+	for _, name := range params.Names {
+		stack, ok := b.stacks[name]
+		if !ok {
+			return nil, errResourceNotFound("stack %s not found", name)
+		}
+		out.Stacks = append(out.Stacks, *copyStack(stack))
+	}
+
+	return out, nil
+}
+
+func (b *Backend) UpdateStack(
+	_ context.Context, params *awsappstream.UpdateStackInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.UpdateStackOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.Name)
+	stack, ok := b.stacks[name]
+	if !ok {
+		return nil, errResourceNotFound("stack %s not found", name)
+	}
+
+	setIfNotNil(&stack.Description, params.Description)
+	setIfNotNil(&stack.DisplayName, params.DisplayName)
+	setIfNotNil(&stack.FeedbackURL, params.FeedbackURL)
+	setIfNotNil(&stack.RedirectURL, params.RedirectURL)
+	setIfNotNil(&stack.StreamingExperienceSettings, params.StreamingExperienceSettings)
+
+	if params.AccessEndpoints != nil {
+		stack.AccessEndpoints = params.AccessEndpoints
+	}
+	if params.EmbedHostDomains != nil {
+		stack.EmbedHostDomains = params.EmbedHostDomains
+	}
+	if params.UserSettings != nil {
+		stack.UserSettings = params.UserSettings
+	}
+	if params.ApplicationSettings != nil {
+		b.setStackApplicationSettings(stack, params.ApplicationSettings)
+	}
+	if aws.ToBool(params.DeleteStorageConnectors) {
+		stack.StorageConnectors = nil
+	}
+	if params.StorageConnectors != nil {
+		stack.StorageConnectors = params.StorageConnectors
+	}
+
+	for _, attr := range params.AttributesToDelete {
+		switch attr {
+		case awstypes.StackAttributeStorageConnectors:
+			stack.StorageConnectors = nil
+		case awstypes.StackAttributeStorageConnectorHomefolders:
+			stack.StorageConnectors = withoutStorageConnector(stack.StorageConnectors, awstypes.StorageConnectorTypeHomefolders)
+		case awstypes.StackAttributeStorageConnectorGoogleDrive:
+			stack.StorageConnectors = withoutStorageConnector(stack.StorageConnectors, awstypes.StorageConnectorTypeGoogleDrive)
+		case awstypes.StackAttributeStorageConnectorOneDrive:
+			stack.StorageConnectors = withoutStorageConnector(stack.StorageConnectors, awstypes.StorageConnectorTypeOneDrive)
+		case awstypes.StackAttributeRedirectUrl:
+			stack.RedirectURL = nil
+		case awstypes.StackAttributeFeedbackUrl:
+			stack.FeedbackURL = nil
+		case awstypes.StackAttributeUserSettings:
+			stack.UserSettings = nil
+		case awstypes.StackAttributeEmbedHostDomains:
+			stack.EmbedHostDomains = nil
+		case awstypes.StackAttributeAccessEndpoints:
+			stack.AccessEndpoints = nil
+		case awstypes.StackAttributeStreamingExperienceSettings:
+			stack.StreamingExperienceSettings = nil
+		}
+	}
+
+	return &awsappstream.UpdateStackOutput{Stack: copyStack(stack)}, nil
+}
+
+func (b *Backend) DeleteStack(
+	_ context.Context, params *awsappstream.DeleteStackInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DeleteStackOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(params.Name)
+	stack, ok := b.stacks[name]
+	if !ok {
+		return nil, errResourceNotFound("stack %s not found", name)
+	}
+
+	for _, stacks := range b.fleetStacks {
+		if _, ok := stacks[name]; ok {
+			return nil, errResourceInUse("stack %s is associated with fleets", name)
+		}
+	}
+
+	// aws drops user associations and entitlements together with the stack
+	for _, stacks := range b.userStacks {
+		delete(stacks, name)
+	}
+	for key := range b.entitlements {
+		if key.stackName == name {
+			delete(b.entitlements, key)
+			delete(b.entitledApplications, key)
+		}
+	}
+
+	delete(b.stacks, name)
+	delete(b.tags, aws.ToString(stack.Arn))
+
+	return &awsappstream.DeleteStackOutput{}, nil
+}
+
+func (b *Backend) setStackApplicationSettings(stack *awstypes.Stack, settings *awstypes.ApplicationSettings) {
+	if settings == nil {
+		stack.ApplicationSettings = nil
+		return
+	}
+
+	response := &awstypes.ApplicationSettingsResponse{
+		Enabled:       settings.Enabled,
+		SettingsGroup: settings.SettingsGroup,
+	}
+	if aws.ToBool(settings.Enabled) {
+		response.S3BucketName = aws.String("appstream-app-settings-" + b.region + "-" + b.accountID)
+	}
+
+	stack.ApplicationSettings = response
+}
+
+func withoutStorageConnector(
+	connectors []awstypes.StorageConnector, connectorType awstypes.StorageConnectorType,
+) []awstypes.StorageConnector {
+	var out []awstypes.StorageConnector
+	for _, c := range connectors {
+		if c.ConnectorType != connectorType {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func copyStack(stack *awstypes.Stack) *awstypes.Stack {
+	out := *stack
+	out.AccessEndpoints = append([]awstypes.AccessEndpoint(nil), stack.AccessEndpoints...)
+	out.EmbedHostDomains = append([]string(nil), stack.EmbedHostDomains...)
+	out.StorageConnectors = append([]awstypes.StorageConnector(nil), stack.StorageConnectors...)
+	out.UserSettings = append([]awstypes.UserSetting(nil), stack.UserSettings...)
+	return &out
+}
@@ -0,0 +1,124 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
+	awstaggingapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstaggingtypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+)
+
+func (b *Backend) GetResources(
+	_ context.Context, params *awstaggingapi.GetResourcesInput, _ ...func(*awstaggingapi.Options),
+) (*awstaggingapi.GetResourcesOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := &awstaggingapi.GetResourcesOutput{}
+
+	arns := params.ResourceARNList
+	if len(arns) == 0 {
+		// without an arn list aws returns every resource matching the type and tag filters
+		arns = sortedKeys(b.tags)
+	}
+
+	for _, arn := range arns {
+		tags, ok := b.tags[arn]
+		if !ok || len(tags) == 0 {
+			// untagged resources are not returned by aws
+			continue
+		}
+
+		if !matchesResourceTypeFilters(arn, params.ResourceTypeFilters) || !matchesTagFilters(tags, params.TagFilters) {
+			continue
+		}
+
+		mapping := awstaggingtypes.ResourceTagMapping{ResourceARN: aws.String(arn)}
+		for _, k := range sortedKeys(tags) {
+			mapping.Tags = append(mapping.Tags, awstaggingtypes.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+		}
+		out.ResourceTagMappingList = append(out.ResourceTagMappingList, mapping)
+	}
+
+	return out, nil
+}
+
+func (b *Backend) TagResources(
+	_ context.Context, params *awstaggingapi.TagResourcesInput, _ ...func(*awstaggingapi.Options),
+) (*awstaggingapi.TagResourcesOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, arn := range params.ResourceARNList {
+		b.setTags(arn, params.Tags)
+	}
+
+	return &awstaggingapi.TagResourcesOutput{}, nil
+}
+
+func (b *Backend) UntagResources(
+	_ context.Context, params *awstaggingapi.UntagResourcesInput, _ ...func(*awstaggingapi.Options),
+) (*awstaggingapi.UntagResourcesOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, arn := range params.ResourceARNList {
+		for _, k := range params.TagKeys {
+			delete(b.tags[arn], k)
+		}
+	}
+
+	return &awstaggingapi.UntagResourcesOutput{}, nil
+}
+
+// matchesResourceTypeFilters reports whether arn is of one of the given "service:type" filters.
+func matchesResourceTypeFilters(arn string, filters []string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+
+	parsed, err := awsarn.Parse(arn)
+	if err != nil {
+		return false
+	}
+
+	resourceType, _, _ := strings.Cut(parsed.Resource, "/")
+	for _, f := range filters {
+		if f == parsed.Service || f == parsed.Service+":"+resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesTagFilters reports whether tags match all filters. A filter without values only requires the key.
+func matchesTagFilters(tags map[string]string, filters []awstaggingtypes.TagFilter) bool {
+	for _, f := range filters {
+		v, ok := tags[aws.ToString(f.Key)]
+		if !ok {
+			return false
+		}
+		if len(f.Values) > 0 && !slices.Contains(f.Values, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Tags returns a copy of the tags stored for the given arn.
+func (b *Backend) Tags(arn string) map[string]string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := make(map[string]string, len(b.tags[arn]))
+	for k, v := range b.tags[arn] {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,78 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// State returns resource state for s with the given attributes set and every other attribute null.
+// Attribute keys are dot separated paths such as "compute_capacity.desired_instances".
+// Together with the backend it drives resource CRUD methods directly, without the terraform CLI.
+func State(t *testing.T, s schema.Schema, attributes map[string]any) tfsdk.State {
+	t.Helper()
+
+	state := tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(context.Background()), nil),
+	}
+	setAttributes(t, &state, attributes)
+
+	return state
+}
+
+// Plan returns a resource plan for s with the given attributes set and every other attribute null.
+func Plan(t *testing.T, s schema.Schema, attributes map[string]any) tfsdk.Plan {
+	t.Helper()
+
+	state := State(t, s, attributes)
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+// Config returns data source configuration for s with the given attributes set and every other attribute null.
+func Config(t *testing.T, s datasourceschema.Schema, attributes map[string]any) tfsdk.Config {
+	t.Helper()
+
+	// unlike a removed resource, a configuration is never null, even without any attributes
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	// config values cannot be set directly, so the raw value is built through a state
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(objectType, values)}
+	setAttributes(t, &state, attributes)
+
+	return tfsdk.Config{Schema: s, Raw: state.Raw}
+}
+
+func setAttributes(t *testing.T, state *tfsdk.State, attributes map[string]any) {
+	t.Helper()
+
+	for key, value := range attributes {
+		diags := state.SetAttribute(context.Background(), attributePath(key), value)
+		if diags.HasError() {
+			t.Fatalf("setting attribute %s: %v", key, diags)
+		}
+	}
+}
+
+func attributePath(key string) path.Path {
+	names := strings.Split(key, ".")
+
+	p := path.Root(names[0])
+	for _, name := range names[1:] {
+		p = p.AtName(name)
+	}
+	return p
+}
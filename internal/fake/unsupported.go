@@ -0,0 +1,236 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"context"
+
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+)
+
+// The operations below belong to app blocks, app block builders, applications,
+// directory configs and the remaining image and image builder operations. They are not
+// modeled by the backend and always fail.
+
+func (b *Backend) AssociateSoftwareToImageBuilder(
+	context.Context, *awsappstream.AssociateSoftwareToImageBuilderInput, ...func(*awsappstream.Options),
+) (*awsappstream.AssociateSoftwareToImageBuilderOutput, error) {
+	return nil, errNotSupported("AssociateSoftwareToImageBuilder")
+}
+
+func (b *Backend) CopyImage(
+	context.Context, *awsappstream.CopyImageInput, ...func(*awsappstream.Options),
+) (*awsappstream.CopyImageOutput, error) {
+	return nil, errNotSupported("CopyImage")
+}
+
+func (b *Backend) CreateAppBlock(
+	context.Context, *awsappstream.CreateAppBlockInput, ...func(*awsappstream.Options),
+) (*awsappstream.CreateAppBlockOutput, error) {
+	return nil, errNotSupported("CreateAppBlock")
+}
+
+func (b *Backend) CreateAppBlockBuilder(
+	context.Context, *awsappstream.CreateAppBlockBuilderInput, ...func(*awsappstream.Options),
+) (*awsappstream.CreateAppBlockBuilderOutput, error) {
+	return nil, errNotSupported("CreateAppBlockBuilder")
+}
+
+func (b *Backend) CreateApplication(
+	context.Context, *awsappstream.CreateApplicationInput, ...func(*awsappstream.Options),
+) (*awsappstream.CreateApplicationOutput, error) {
+	return nil, errNotSupported("CreateApplication")
+}
+
+func (b *Backend) CreateDirectoryConfig(
+	context.Context, *awsappstream.CreateDirectoryConfigInput, ...func(*awsappstream.Options),
+) (*awsappstream.CreateDirectoryConfigOutput, error) {
+	return nil, errNotSupported("CreateDirectoryConfig")
+}
+
+func (b *Backend) CreateImageBuilderStreamingURL(
+	context.Context, *awsappstream.CreateImageBuilderStreamingURLInput, ...func(*awsappstream.Options),
+) (*awsappstream.CreateImageBuilderStreamingURLOutput, error) {
+	return nil, errNotSupported("CreateImageBuilderStreamingURL")
+}
+
+func (b *Backend) CreateStreamingURL(
+	context.Context, *awsappstream.CreateStreamingURLInput, ...func(*awsappstream.Options),
+) (*awsappstream.CreateStreamingURLOutput, error) {
+	return nil, errNotSupported("CreateStreamingURL")
+}
+
+func (b *Backend) CreateThemeForStack(
+	context.Context, *awsappstream.CreateThemeForStackInput, ...func(*awsappstream.Options),
+) (*awsappstream.CreateThemeForStackOutput, error) {
+	return nil, errNotSupported("CreateThemeForStack")
+}
+
+func (b *Backend) CreateUpdatedImage(
+	context.Context, *awsappstream.CreateUpdatedImageInput, ...func(*awsappstream.Options),
+) (*awsappstream.CreateUpdatedImageOutput, error) {
+	return nil, errNotSupported("CreateUpdatedImage")
+}
+
+func (b *Backend) CreateUsageReportSubscription(
+	context.Context, *awsappstream.CreateUsageReportSubscriptionInput, ...func(*awsappstream.Options),
+) (*awsappstream.CreateUsageReportSubscriptionOutput, error) {
+	return nil, errNotSupported("CreateUsageReportSubscription")
+}
+
+func (b *Backend) DeleteAppBlock(
+	context.Context, *awsappstream.DeleteAppBlockInput, ...func(*awsappstream.Options),
+) (*awsappstream.DeleteAppBlockOutput, error) {
+	return nil, errNotSupported("DeleteAppBlock")
+}
+
+func (b *Backend) DeleteAppBlockBuilder(
+	context.Context, *awsappstream.DeleteAppBlockBuilderInput, ...func(*awsappstream.Options),
+) (*awsappstream.DeleteAppBlockBuilderOutput, error) {
+	return nil, errNotSupported("DeleteAppBlockBuilder")
+}
+
+func (b *Backend) DeleteApplication(
+	context.Context, *awsappstream.DeleteApplicationInput, ...func(*awsappstream.Options),
+) (*awsappstream.DeleteApplicationOutput, error) {
+	return nil, errNotSupported("DeleteApplication")
+}
+
+func (b *Backend) DeleteDirectoryConfig(
+	context.Context, *awsappstream.DeleteDirectoryConfigInput, ...func(*awsappstream.Options),
+) (*awsappstream.DeleteDirectoryConfigOutput, error) {
+	return nil, errNotSupported("DeleteDirectoryConfig")
+}
+
+func (b *Backend) DeleteImagePermissions(
+	context.Context, *awsappstream.DeleteImagePermissionsInput, ...func(*awsappstream.Options),
+) (*awsappstream.DeleteImagePermissionsOutput, error) {
+	return nil, errNotSupported("DeleteImagePermissions")
+}
+
+func (b *Backend) DeleteThemeForStack(
+	context.Context, *awsappstream.DeleteThemeForStackInput, ...func(*awsappstream.Options),
+) (*awsappstream.DeleteThemeForStackOutput, error) {
+	return nil, errNotSupported("DeleteThemeForStack")
+}
+
+func (b *Backend) DeleteUsageReportSubscription(
+	context.Context, *awsappstream.DeleteUsageReportSubscriptionInput, ...func(*awsappstream.Options),
+) (*awsappstream.DeleteUsageReportSubscriptionOutput, error) {
+	return nil, errNotSupported("DeleteUsageReportSubscription")
+}
+
+func (b *Backend) DescribeAppBlockBuilders(
+	context.Context, *awsappstream.DescribeAppBlockBuildersInput, ...func(*awsappstream.Options),
+) (*awsappstream.DescribeAppBlockBuildersOutput, error) {
+	return nil, errNotSupported("DescribeAppBlockBuilders")
+}
+
+func (b *Backend) DescribeAppBlocks(
+	context.Context, *awsappstream.DescribeAppBlocksInput, ...func(*awsappstream.Options),
+) (*awsappstream.DescribeAppBlocksOutput, error) {
+	return nil, errNotSupported("DescribeAppBlocks")
+}
+
+func (b *Backend) DescribeApplications(
+	context.Context, *awsappstream.DescribeApplicationsInput, ...func(*awsappstream.Options),
+) (*awsappstream.DescribeApplicationsOutput, error) {
+	return nil, errNotSupported("DescribeApplications")
+}
+
+func (b *Backend) DescribeDirectoryConfigs(
+	context.Context, *awsappstream.DescribeDirectoryConfigsInput, ...func(*awsappstream.Options),
+) (*awsappstream.DescribeDirectoryConfigsOutput, error) {
+	return nil, errNotSupported("DescribeDirectoryConfigs")
+}
+
+func (b *Backend) DescribeImagePermissions(
+	context.Context, *awsappstream.DescribeImagePermissionsInput, ...func(*awsappstream.Options),
+) (*awsappstream.DescribeImagePermissionsOutput, error) {
+	return nil, errNotSupported("DescribeImagePermissions")
+}
+
+func (b *Backend) DescribeSessions(
+	context.Context, *awsappstream.DescribeSessionsInput, ...func(*awsappstream.Options),
+) (*awsappstream.DescribeSessionsOutput, error) {
+	return nil, errNotSupported("DescribeSessions")
+}
+
+func (b *Backend) DescribeSoftwareAssociations(
+	context.Context, *awsappstream.DescribeSoftwareAssociationsInput, ...func(*awsappstream.Options),
+) (*awsappstream.DescribeSoftwareAssociationsOutput, error) {
+	return nil, errNotSupported("DescribeSoftwareAssociations")
+}
+
+func (b *Backend) DescribeThemeForStack(
+	context.Context, *awsappstream.DescribeThemeForStackInput, ...func(*awsappstream.Options),
+) (*awsappstream.DescribeThemeForStackOutput, error) {
+	return nil, errNotSupported("DescribeThemeForStack")
+}
+
+func (b *Backend) DescribeUsageReportSubscriptions(
+	context.Context, *awsappstream.DescribeUsageReportSubscriptionsInput, ...func(*awsappstream.Options),
+) (*awsappstream.DescribeUsageReportSubscriptionsOutput, error) {
+	return nil, errNotSupported("DescribeUsageReportSubscriptions")
+}
+
+func (b *Backend) DisassociateSoftwareFromImageBuilder(
+	context.Context, *awsappstream.DisassociateSoftwareFromImageBuilderInput, ...func(*awsappstream.Options),
+) (*awsappstream.DisassociateSoftwareFromImageBuilderOutput, error) {
+	return nil, errNotSupported("DisassociateSoftwareFromImageBuilder")
+}
+
+func (b *Backend) ExpireSession(
+	context.Context, *awsappstream.ExpireSessionInput, ...func(*awsappstream.Options),
+) (*awsappstream.ExpireSessionOutput, error) {
+	return nil, errNotSupported("ExpireSession")
+}
+
+func (b *Backend) StartAppBlockBuilder(
+	context.Context, *awsappstream.StartAppBlockBuilderInput, ...func(*awsappstream.Options),
+) (*awsappstream.StartAppBlockBuilderOutput, error) {
+	return nil, errNotSupported("StartAppBlockBuilder")
+}
+
+func (b *Backend) StartSoftwareDeploymentToImageBuilder(
+	context.Context, *awsappstream.StartSoftwareDeploymentToImageBuilderInput, ...func(*awsappstream.Options),
+) (*awsappstream.StartSoftwareDeploymentToImageBuilderOutput, error) {
+	return nil, errNotSupported("StartSoftwareDeploymentToImageBuilder")
+}
+
+func (b *Backend) StopAppBlockBuilder(
+	context.Context, *awsappstream.StopAppBlockBuilderInput, ...func(*awsappstream.Options),
+) (*awsappstream.StopAppBlockBuilderOutput, error) {
+	return nil, errNotSupported("StopAppBlockBuilder")
+}
+
+func (b *Backend) UpdateAppBlockBuilder(
+	context.Context, *awsappstream.UpdateAppBlockBuilderInput, ...func(*awsappstream.Options),
+) (*awsappstream.UpdateAppBlockBuilderOutput, error) {
+	return nil, errNotSupported("UpdateAppBlockBuilder")
+}
+
+func (b *Backend) UpdateApplication(
+	context.Context, *awsappstream.UpdateApplicationInput, ...func(*awsappstream.Options),
+) (*awsappstream.UpdateApplicationOutput, error) {
+	return nil, errNotSupported("UpdateApplication")
+}
+
+func (b *Backend) UpdateDirectoryConfig(
+	context.Context, *awsappstream.UpdateDirectoryConfigInput, ...func(*awsappstream.Options),
+) (*awsappstream.UpdateDirectoryConfigOutput, error) {
+	return nil, errNotSupported("UpdateDirectoryConfig")
+}
+
+func (b *Backend) UpdateImagePermissions(
+	context.Context, *awsappstream.UpdateImagePermissionsInput, ...func(*awsappstream.Options),
+) (*awsappstream.UpdateImagePermissionsOutput, error) {
+	return nil, errNotSupported("UpdateImagePermissions")
+}
+
+func (b *Backend) UpdateThemeForStack(
+	context.Context, *awsappstream.UpdateThemeForStackInput, ...func(*awsappstream.Options),
+) (*awsappstream.UpdateThemeForStackOutput, error) {
+	return nil, errNotSupported("UpdateThemeForStack")
+}
@@ -0,0 +1,128 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+)
+
+type userKey struct {
+	authenticationType awstypes.AuthenticationType
+	userName           string
+}
+
+func (b *Backend) CreateUser(
+	_ context.Context, params *awsappstream.CreateUserInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.CreateUserOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := userKey{params.AuthenticationType, aws.ToString(params.UserName)}
+	if _, ok := b.users[key]; ok {
+		return nil, errResourceAlreadyExists("user %s already exists", key.userName)
+	}
+
+	b.users[key] = &awstypes.User{
+		Arn:                aws.String(b.arn("user/"+string(key.authenticationType), key.userName)),
+		AuthenticationType: key.authenticationType,
+		UserName:           aws.String(key.userName),
+		FirstName:          params.FirstName,
+		LastName:           params.LastName,
+		Enabled:            aws.Bool(true),
+		Status:             aws.String("FORCE_CHANGE_PASSWORD"),
+		CreatedTime:        b.createdTime(),
+	}
+
+	return &awsappstream.CreateUserOutput{}, nil
+}
+
+func (b *Backend) DescribeUsers(
+	_ context.Context, params *awsappstream.DescribeUsersInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DescribeUsersOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := &awsappstream.DescribeUsersOutput{}
+
+	for _, key := range sortedUserKeys(b.users) {
+		if key.authenticationType != params.AuthenticationType {
+			continue
+		}
+		user := *b.users[key]
+		out.Users = append(out.Users, user)
+	}
+
+	return out, nil
+}
+
+func (b *Backend) DeleteUser(
+	_ context.Context, params *awsappstream.DeleteUserInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DeleteUserOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := userKey{params.AuthenticationType, aws.ToString(params.UserName)}
+	if _, ok := b.users[key]; !ok {
+		return nil, errResourceNotFound("user %s not found", key.userName)
+	}
+
+	delete(b.users, key)
+	delete(b.userStacks, key)
+
+	return &awsappstream.DeleteUserOutput{}, nil
+}
+
+func (b *Backend) EnableUser(
+	_ context.Context, params *awsappstream.EnableUserInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.EnableUserOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := userKey{params.AuthenticationType, aws.ToString(params.UserName)}
+	user, ok := b.users[key]
+	if !ok {
+		return nil, errResourceNotFound("user %s not found", key.userName)
+	}
+
+	user.Enabled = aws.Bool(true)
+
+	return &awsappstream.EnableUserOutput{}, nil
+}
+
+func (b *Backend) DisableUser(
+	_ context.Context, params *awsappstream.DisableUserInput, _ ...func(*awsappstream.Options),
+) (*awsappstream.DisableUserOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := userKey{params.AuthenticationType, aws.ToString(params.UserName)}
+	user, ok := b.users[key]
+	if !ok {
+		return nil, errResourceNotFound("user %s not found", key.userName)
+	}
+
+	user.Enabled = aws.Bool(false)
+
+	return &awsappstream.DisableUserOutput{}, nil
+}
+
+func sortedUserKeys[V any](m map[userKey]V) []userKey {
+	keys := make([]userKey, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b userKey) int {
+		return cmp.Or(
+			cmp.Compare(a.authenticationType, b.authenticationType),
+			cmp.Compare(a.userName, b.userName),
+		)
+	})
+	return keys
+}
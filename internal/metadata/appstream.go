@@ -0,0 +1,332 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+import (
+	"context"
+
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+)
+
+// AppStreamAPI is the subset of the AppStream client used by resources and data sources.
+// It is satisfied by *awsappstream.Client and by in-memory fakes used in tests.
+type AppStreamAPI interface {
+	AssociateAppBlockBuilderAppBlock(
+		ctx context.Context, params *awsappstream.AssociateAppBlockBuilderAppBlockInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.AssociateAppBlockBuilderAppBlockOutput, error)
+
+	AssociateApplicationFleet(
+		ctx context.Context, params *awsappstream.AssociateApplicationFleetInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.AssociateApplicationFleetOutput, error)
+
+	AssociateApplicationToEntitlement(
+		ctx context.Context, params *awsappstream.AssociateApplicationToEntitlementInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.AssociateApplicationToEntitlementOutput, error)
+
+	AssociateFleet(
+		ctx context.Context, params *awsappstream.AssociateFleetInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.AssociateFleetOutput, error)
+
+	AssociateSoftwareToImageBuilder(
+		ctx context.Context, params *awsappstream.AssociateSoftwareToImageBuilderInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.AssociateSoftwareToImageBuilderOutput, error)
+
+	BatchAssociateUserStack(
+		ctx context.Context, params *awsappstream.BatchAssociateUserStackInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.BatchAssociateUserStackOutput, error)
+
+	BatchDisassociateUserStack(
+		ctx context.Context, params *awsappstream.BatchDisassociateUserStackInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.BatchDisassociateUserStackOutput, error)
+
+	CopyImage(
+		ctx context.Context, params *awsappstream.CopyImageInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CopyImageOutput, error)
+
+	CreateAppBlock(
+		ctx context.Context, params *awsappstream.CreateAppBlockInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateAppBlockOutput, error)
+
+	CreateAppBlockBuilder(
+		ctx context.Context, params *awsappstream.CreateAppBlockBuilderInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateAppBlockBuilderOutput, error)
+
+	CreateApplication(
+		ctx context.Context, params *awsappstream.CreateApplicationInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateApplicationOutput, error)
+
+	CreateDirectoryConfig(
+		ctx context.Context, params *awsappstream.CreateDirectoryConfigInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateDirectoryConfigOutput, error)
+
+	CreateEntitlement(
+		ctx context.Context, params *awsappstream.CreateEntitlementInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateEntitlementOutput, error)
+
+	CreateFleet(
+		ctx context.Context, params *awsappstream.CreateFleetInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateFleetOutput, error)
+
+	CreateImageBuilder(
+		ctx context.Context, params *awsappstream.CreateImageBuilderInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateImageBuilderOutput, error)
+
+	CreateImageBuilderStreamingURL(
+		ctx context.Context, params *awsappstream.CreateImageBuilderStreamingURLInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateImageBuilderStreamingURLOutput, error)
+
+	CreateStack(
+		ctx context.Context, params *awsappstream.CreateStackInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateStackOutput, error)
+
+	CreateStreamingURL(
+		ctx context.Context, params *awsappstream.CreateStreamingURLInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateStreamingURLOutput, error)
+
+	CreateThemeForStack(
+		ctx context.Context, params *awsappstream.CreateThemeForStackInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateThemeForStackOutput, error)
+
+	CreateUpdatedImage(
+		ctx context.Context, params *awsappstream.CreateUpdatedImageInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateUpdatedImageOutput, error)
+
+	CreateUsageReportSubscription(
+		ctx context.Context, params *awsappstream.CreateUsageReportSubscriptionInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateUsageReportSubscriptionOutput, error)
+
+	CreateUser(
+		ctx context.Context, params *awsappstream.CreateUserInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.CreateUserOutput, error)
+
+	DeleteAppBlock(
+		ctx context.Context, params *awsappstream.DeleteAppBlockInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteAppBlockOutput, error)
+
+	DeleteAppBlockBuilder(
+		ctx context.Context, params *awsappstream.DeleteAppBlockBuilderInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteAppBlockBuilderOutput, error)
+
+	DeleteApplication(
+		ctx context.Context, params *awsappstream.DeleteApplicationInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteApplicationOutput, error)
+
+	DeleteDirectoryConfig(
+		ctx context.Context, params *awsappstream.DeleteDirectoryConfigInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteDirectoryConfigOutput, error)
+
+	DeleteEntitlement(
+		ctx context.Context, params *awsappstream.DeleteEntitlementInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteEntitlementOutput, error)
+
+	DeleteFleet(
+		ctx context.Context, params *awsappstream.DeleteFleetInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteFleetOutput, error)
+
+	DeleteImage(
+		ctx context.Context, params *awsappstream.DeleteImageInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteImageOutput, error)
+
+	DeleteImageBuilder(
+		ctx context.Context, params *awsappstream.DeleteImageBuilderInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteImageBuilderOutput, error)
+
+	DeleteImagePermissions(
+		ctx context.Context, params *awsappstream.DeleteImagePermissionsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteImagePermissionsOutput, error)
+
+	DeleteStack(
+		ctx context.Context, params *awsappstream.DeleteStackInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteStackOutput, error)
+
+	DeleteThemeForStack(
+		ctx context.Context, params *awsappstream.DeleteThemeForStackInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteThemeForStackOutput, error)
+
+	DeleteUsageReportSubscription(
+		ctx context.Context, params *awsappstream.DeleteUsageReportSubscriptionInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteUsageReportSubscriptionOutput, error)
+
+	DeleteUser(
+		ctx context.Context, params *awsappstream.DeleteUserInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DeleteUserOutput, error)
+
+	DescribeAppBlockBuilderAppBlockAssociations(
+		ctx context.Context, params *awsappstream.DescribeAppBlockBuilderAppBlockAssociationsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeAppBlockBuilderAppBlockAssociationsOutput, error)
+
+	DescribeAppBlockBuilders(
+		ctx context.Context, params *awsappstream.DescribeAppBlockBuildersInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeAppBlockBuildersOutput, error)
+
+	DescribeAppBlocks(
+		ctx context.Context, params *awsappstream.DescribeAppBlocksInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeAppBlocksOutput, error)
+
+	DescribeApplicationFleetAssociations(
+		ctx context.Context, params *awsappstream.DescribeApplicationFleetAssociationsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeApplicationFleetAssociationsOutput, error)
+
+	DescribeApplications(
+		ctx context.Context, params *awsappstream.DescribeApplicationsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeApplicationsOutput, error)
+
+	DescribeDirectoryConfigs(
+		ctx context.Context, params *awsappstream.DescribeDirectoryConfigsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeDirectoryConfigsOutput, error)
+
+	DescribeEntitlements(
+		ctx context.Context, params *awsappstream.DescribeEntitlementsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeEntitlementsOutput, error)
+
+	DescribeFleets(
+		ctx context.Context, params *awsappstream.DescribeFleetsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeFleetsOutput, error)
+
+	DescribeImageBuilders(
+		ctx context.Context, params *awsappstream.DescribeImageBuildersInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeImageBuildersOutput, error)
+
+	DescribeImagePermissions(
+		ctx context.Context, params *awsappstream.DescribeImagePermissionsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeImagePermissionsOutput, error)
+
+	DescribeImages(
+		ctx context.Context, params *awsappstream.DescribeImagesInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeImagesOutput, error)
+
+	DescribeSessions(
+		ctx context.Context, params *awsappstream.DescribeSessionsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeSessionsOutput, error)
+
+	DescribeSoftwareAssociations(
+		ctx context.Context, params *awsappstream.DescribeSoftwareAssociationsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeSoftwareAssociationsOutput, error)
+
+	DescribeStacks(
+		ctx context.Context, params *awsappstream.DescribeStacksInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeStacksOutput, error)
+
+	DescribeThemeForStack(
+		ctx context.Context, params *awsappstream.DescribeThemeForStackInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeThemeForStackOutput, error)
+
+	DescribeUsageReportSubscriptions(
+		ctx context.Context, params *awsappstream.DescribeUsageReportSubscriptionsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeUsageReportSubscriptionsOutput, error)
+
+	DescribeUserStackAssociations(
+		ctx context.Context, params *awsappstream.DescribeUserStackAssociationsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeUserStackAssociationsOutput, error)
+
+	DescribeUsers(
+		ctx context.Context, params *awsappstream.DescribeUsersInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DescribeUsersOutput, error)
+
+	DisableUser(
+		ctx context.Context, params *awsappstream.DisableUserInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DisableUserOutput, error)
+
+	DisassociateAppBlockBuilderAppBlock(
+		ctx context.Context, params *awsappstream.DisassociateAppBlockBuilderAppBlockInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DisassociateAppBlockBuilderAppBlockOutput, error)
+
+	DisassociateApplicationFleet(
+		ctx context.Context, params *awsappstream.DisassociateApplicationFleetInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DisassociateApplicationFleetOutput, error)
+
+	DisassociateApplicationFromEntitlement(
+		ctx context.Context, params *awsappstream.DisassociateApplicationFromEntitlementInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DisassociateApplicationFromEntitlementOutput, error)
+
+	DisassociateFleet(
+		ctx context.Context, params *awsappstream.DisassociateFleetInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DisassociateFleetOutput, error)
+
+	DisassociateSoftwareFromImageBuilder(
+		ctx context.Context, params *awsappstream.DisassociateSoftwareFromImageBuilderInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.DisassociateSoftwareFromImageBuilderOutput, error)
+
+	EnableUser(
+		ctx context.Context, params *awsappstream.EnableUserInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.EnableUserOutput, error)
+
+	ExpireSession(
+		ctx context.Context, params *awsappstream.ExpireSessionInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.ExpireSessionOutput, error)
+
+	ListAssociatedFleets(
+		ctx context.Context, params *awsappstream.ListAssociatedFleetsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.ListAssociatedFleetsOutput, error)
+
+	ListAssociatedStacks(
+		ctx context.Context, params *awsappstream.ListAssociatedStacksInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.ListAssociatedStacksOutput, error)
+
+	ListEntitledApplications(
+		ctx context.Context, params *awsappstream.ListEntitledApplicationsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.ListEntitledApplicationsOutput, error)
+
+	StartAppBlockBuilder(
+		ctx context.Context, params *awsappstream.StartAppBlockBuilderInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.StartAppBlockBuilderOutput, error)
+
+	StartFleet(
+		ctx context.Context, params *awsappstream.StartFleetInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.StartFleetOutput, error)
+
+	StartImageBuilder(
+		ctx context.Context, params *awsappstream.StartImageBuilderInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.StartImageBuilderOutput, error)
+
+	StartSoftwareDeploymentToImageBuilder(
+		ctx context.Context, params *awsappstream.StartSoftwareDeploymentToImageBuilderInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.StartSoftwareDeploymentToImageBuilderOutput, error)
+
+	StopAppBlockBuilder(
+		ctx context.Context, params *awsappstream.StopAppBlockBuilderInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.StopAppBlockBuilderOutput, error)
+
+	StopFleet(
+		ctx context.Context, params *awsappstream.StopFleetInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.StopFleetOutput, error)
+
+	StopImageBuilder(
+		ctx context.Context, params *awsappstream.StopImageBuilderInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.StopImageBuilderOutput, error)
+
+	UpdateAppBlockBuilder(
+		ctx context.Context, params *awsappstream.UpdateAppBlockBuilderInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.UpdateAppBlockBuilderOutput, error)
+
+	UpdateApplication(
+		ctx context.Context, params *awsappstream.UpdateApplicationInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.UpdateApplicationOutput, error)
+
+	UpdateDirectoryConfig(
+		ctx context.Context, params *awsappstream.UpdateDirectoryConfigInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.UpdateDirectoryConfigOutput, error)
+
+	UpdateEntitlement(
+		ctx context.Context, params *awsappstream.UpdateEntitlementInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.UpdateEntitlementOutput, error)
+
+	UpdateFleet(
+		ctx context.Context, params *awsappstream.UpdateFleetInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.UpdateFleetOutput, error)
+
+	UpdateImagePermissions(
+		ctx context.Context, params *awsappstream.UpdateImagePermissionsInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.UpdateImagePermissionsOutput, error)
+
+	UpdateStack(
+		ctx context.Context, params *awsappstream.UpdateStackInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.UpdateStackOutput, error)
+
+	UpdateThemeForStack(
+		ctx context.Context, params *awsappstream.UpdateThemeForStackInput, optFns ...func(*awsappstream.Options),
+	) (*awsappstream.UpdateThemeForStackOutput, error)
+}
+
+var _ AppStreamAPI = (*awsappstream.Client)(nil)
@@ -4,21 +4,88 @@
 package metadata
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
 	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
 	awstaggingapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/tags"
 )
 
 type Metadata struct {
-	Appstream   *awsappstream.Client
-	Tagging     *awstaggingapi.Client
+	Appstream   AppStreamAPI
+	Tagging     TaggingAPI
 	DefaultTags map[string]string
+	IgnoreTags  tags.IgnoreTags
+
+	// AppstreamForRegion returns an AppStream client for region that uses the configured credentials.
+	// It is used by resources that act on another region than the configured one, e.g. image copies.
+	AppstreamForRegion func(region string) AppStreamAPI
+
+	// AccountID is the account of the configured credentials. It is empty if credential validation was skipped.
+	AccountID string
+	// Partition is the AWS partition of the configured credentials or, if unknown, derived from Region.
+	Partition string
+	// Region is the configured region.
+	Region string
+}
+
+// Endpoints holds custom service endpoint URLs. Empty values use the AWS SDK default endpoint resolution.
+type Endpoints struct {
+	AppStream                string
+	STS                      string
+	ResourceGroupsTaggingAPI string
 }
 
-func NewMetadata(awscfg aws.Config, defaultTags map[string]string) *Metadata {
+func NewMetadata(awscfg aws.Config, endpoints Endpoints, defaultTags map[string]string) *Metadata {
 	return &Metadata{
-		Appstream:   awsappstream.NewFromConfig(awscfg),
-		Tagging:     awstaggingapi.NewFromConfig(awscfg),
+		Appstream: awsappstream.NewFromConfig(awscfg, func(o *awsappstream.Options) {
+			if endpoints.AppStream != "" {
+				o.BaseEndpoint = aws.String(endpoints.AppStream)
+			}
+		}),
+		Tagging: awstaggingapi.NewFromConfig(awscfg, func(o *awstaggingapi.Options) {
+			if endpoints.ResourceGroupsTaggingAPI != "" {
+				o.BaseEndpoint = aws.String(endpoints.ResourceGroupsTaggingAPI)
+			}
+		}),
+		AppstreamForRegion: func(region string) AppStreamAPI {
+			return awsappstream.NewFromConfig(awscfg, func(o *awsappstream.Options) {
+				o.Region = region
+				// a custom endpoint serves the configured region only
+				if endpoints.AppStream != "" && region == awscfg.Region {
+					o.BaseEndpoint = aws.String(endpoints.AppStream)
+				}
+			})
+		},
 		DefaultTags: defaultTags,
 	}
 }
+
+// ARN builds an AppStream ARN for resource in the configured partition, region and account.
+func (m *Metadata) ARN(resource string) string {
+	return awsarn.ARN{
+		Partition: m.Partition,
+		Service:   "appstream",
+		Region:    m.Region,
+		AccountID: m.AccountID,
+		Resource:  resource,
+	}.String()
+}
+
+// PartitionForRegion returns the AWS partition a region belongs to.
+func PartitionForRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	case strings.HasPrefix(region, "us-isob-"):
+		return "aws-iso-b"
+	case strings.HasPrefix(region, "us-iso-"):
+		return "aws-iso"
+	default:
+		return "aws"
+	}
+}
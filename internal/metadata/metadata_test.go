@@ -0,0 +1,72 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+)
+
+func TestPartitionForRegion(t *testing.T) {
+	tests := []struct {
+		region string
+		want   string
+	}{
+		{region: "eu-central-1", want: "aws"},
+		{region: "us-east-1", want: "aws"},
+		{region: "", want: "aws"},
+		{region: "cn-north-1", want: "aws-cn"},
+		{region: "us-gov-west-1", want: "aws-us-gov"},
+		{region: "us-iso-east-1", want: "aws-iso"},
+		{region: "us-isob-east-1", want: "aws-iso-b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.region, func(t *testing.T) {
+			if got := PartitionForRegion(tt.region); got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMetadataARN(t *testing.T) {
+	m := &Metadata{Partition: "aws-cn", Region: "cn-north-1", AccountID: "123456789012"}
+
+	want := "arn:aws-cn:appstream:cn-north-1:123456789012:fleet/example"
+	if got := m.ARN("fleet/example"); got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestMetadataAppstreamForRegion(t *testing.T) {
+	m := NewMetadata(aws.Config{Region: "eu-central-1"}, Endpoints{AppStream: "http://localhost:4566"}, nil)
+
+	tests := []struct {
+		region       string
+		wantEndpoint string
+	}{
+		{region: "eu-central-1", wantEndpoint: "http://localhost:4566"},
+		{region: "us-east-1", wantEndpoint: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.region, func(t *testing.T) {
+			client, ok := m.AppstreamForRegion(tt.region).(*awsappstream.Client)
+			if !ok {
+				t.Fatalf("expected *awsappstream.Client")
+			}
+
+			opts := client.Options()
+			if opts.Region != tt.region {
+				t.Fatalf("expected region %q, got %q", tt.region, opts.Region)
+			}
+			if got := aws.ToString(opts.BaseEndpoint); got != tt.wantEndpoint {
+				t.Fatalf("expected endpoint %q, got %q", tt.wantEndpoint, got)
+			}
+		})
+	}
+}
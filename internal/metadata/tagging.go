@@ -0,0 +1,28 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+import (
+	"context"
+
+	awstaggingapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+)
+
+// TaggingAPI is the subset of the Resource Groups Tagging client used by the tag manager.
+// It is satisfied by *awstaggingapi.Client and by in-memory fakes used in tests.
+type TaggingAPI interface {
+	GetResources(
+		ctx context.Context, params *awstaggingapi.GetResourcesInput, optFns ...func(*awstaggingapi.Options),
+	) (*awstaggingapi.GetResourcesOutput, error)
+
+	TagResources(
+		ctx context.Context, params *awstaggingapi.TagResourcesInput, optFns ...func(*awstaggingapi.Options),
+	) (*awstaggingapi.TagResourcesOutput, error)
+
+	UntagResources(
+		ctx context.Context, params *awstaggingapi.UntagResourcesInput, optFns ...func(*awstaggingapi.Options),
+	) (*awstaggingapi.UntagResourcesOutput, error)
+}
+
+var _ TaggingAPI = (*awstaggingapi.Client)(nil)
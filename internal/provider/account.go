@@ -0,0 +1,33 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var accountIDRegex = regexp.MustCompile(`^\d{12}$`)
+
+var (
+	ErrAccountNotAllowed = errors.New("AWS account is not allowed")
+	ErrAccountForbidden  = errors.New("AWS account is forbidden")
+)
+
+// checkAccountID fails if accountID is not part of allowed or part of forbidden.
+// An empty allowed list allows every account.
+func checkAccountID(accountID string, allowed, forbidden []string) error {
+	if len(allowed) > 0 && !slices.Contains(allowed, accountID) {
+		return fmt.Errorf("%w: account=%s allowed=%s", ErrAccountNotAllowed, accountID, strings.Join(allowed, ","))
+	}
+
+	if slices.Contains(forbidden, accountID) {
+		return fmt.Errorf("%w: account=%s", ErrAccountForbidden, accountID)
+	}
+
+	return nil
+}
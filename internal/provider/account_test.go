@@ -0,0 +1,60 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAccountID(t *testing.T) {
+	tests := []struct {
+		name      string
+		accountID string
+		allowed   []string
+		forbidden []string
+		wantErr   error
+	}{
+		{
+			name:      "no_restrictions",
+			accountID: "123456789012",
+		},
+		{
+			name:      "allowed",
+			accountID: "123456789012",
+			allowed:   []string{"111111111111", "123456789012"},
+		},
+		{
+			name:      "not_allowed",
+			accountID: "123456789012",
+			allowed:   []string{"111111111111"},
+			wantErr:   ErrAccountNotAllowed,
+		},
+		{
+			name:      "not_forbidden",
+			accountID: "123456789012",
+			forbidden: []string{"111111111111"},
+		},
+		{
+			name:      "forbidden",
+			accountID: "123456789012",
+			forbidden: []string{"123456789012"},
+			wantErr:   ErrAccountForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkAccountID(tt.accountID, tt.allowed, tt.forbidden)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+const (
+	envWebIdentityTokenFile = "AWS_WEB_IDENTITY_TOKEN_FILE"
+
+	assumeRoleMinDuration = 15 * time.Minute
+	assumeRoleMaxDuration = 12 * time.Hour
+)
+
+type assumeRoleModel struct {
+	RoleARN           types.String `tfsdk:"role_arn"`
+	SessionName       types.String `tfsdk:"session_name"`
+	ExternalID        types.String `tfsdk:"external_id"`
+	Duration          types.String `tfsdk:"duration"`
+	Policy            types.String `tfsdk:"policy"`
+	Tags              types.Map    `tfsdk:"tags"`
+	TransitiveTagKeys types.Set    `tfsdk:"transitive_tag_keys"`
+}
+
+type assumeRoleWithWebIdentityModel struct {
+	RoleARN              types.String `tfsdk:"role_arn"`
+	SessionName          types.String `tfsdk:"session_name"`
+	WebIdentityToken     types.String `tfsdk:"web_identity_token"`
+	WebIdentityTokenFile types.String `tfsdk:"web_identity_token_file"`
+	Duration             types.String `tfsdk:"duration"`
+	Policy               types.String `tfsdk:"policy"`
+}
+
+func assumeRoleSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "IAM role to assume using the configured credentials.",
+		MarkdownDescription: "An IAM role to assume via STS `AssumeRole`. " +
+			"The credentials resolved from `access_key`/`secret_access_key`, `profile` or the AWS SDK default chain " +
+			"are used to assume the role. Conflicts with `assume_role_with_web_identity`.",
+		Attributes: map[string]schema.Attribute{
+			"role_arn": schema.StringAttribute{
+				Required:            true,
+				Description:         "ARN of the IAM role to assume.",
+				MarkdownDescription: "The ARN of the IAM role to assume.",
+				Validators:          []validator.String{util.ValidARNWithServiceAndResource("iam", "role/")},
+			},
+			"session_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Session name to use when assuming the role.",
+				MarkdownDescription: "The session name to use when assuming the role. If not set, the AWS SDK generates one.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(2, 64)},
+			},
+			"external_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "External ID to use when assuming the role.",
+				MarkdownDescription: "The external ID to use when assuming the role, if required by the role's trust policy.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(2, 1224)},
+			},
+			"duration": schema.StringAttribute{
+				Optional:    true,
+				Description: "Duration of the assumed role session, e.g. 1h.",
+				MarkdownDescription: "The duration of the assumed role session as a Go duration string, e.g. `1h` or `90m`. " +
+					"Must be between `15m` and `12h`. If not set, the AWS SDK default of `15m` is used.",
+			},
+			"policy": schema.StringAttribute{
+				Optional:            true,
+				Description:         "IAM policy JSON further restricting the assumed role session.",
+				MarkdownDescription: "An IAM policy in JSON format that further restricts the permissions of the assumed role session.",
+			},
+			"tags": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Session tags to pass when assuming the role.",
+				MarkdownDescription: "A map of session tags to pass when assuming the role.",
+			},
+			"transitive_tag_keys": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Session tag keys to pass to subsequent sessions in a role chain.",
+				MarkdownDescription: "A set of session tag keys to pass to subsequent sessions in a role chain.",
+			},
+		},
+	}
+}
+
+func assumeRoleWithWebIdentitySchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "IAM role to assume using a web identity token.",
+		MarkdownDescription: "An IAM role to assume via STS `AssumeRoleWithWebIdentity`, for example with an OIDC token " +
+			"issued by a CI system. Conflicts with `assume_role`.",
+		Attributes: map[string]schema.Attribute{
+			"role_arn": schema.StringAttribute{
+				Required:            true,
+				Description:         "ARN of the IAM role to assume.",
+				MarkdownDescription: "The ARN of the IAM role to assume.",
+				Validators:          []validator.String{util.ValidARNWithServiceAndResource("iam", "role/")},
+			},
+			"session_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Session name to use when assuming the role.",
+				MarkdownDescription: "The session name to use when assuming the role. If not set, the AWS SDK generates one.",
+				Validators:          []validator.String{stringvalidator.LengthBetween(2, 64)},
+			},
+			"web_identity_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Web identity token to exchange for role credentials.",
+				MarkdownDescription: "The OAuth 2.0 access token or OpenID Connect ID token to exchange for role credentials. " +
+					"Conflicts with `web_identity_token_file`.",
+				Validators: []validator.String{stringvalidator.LengthAtLeast(4)},
+			},
+			"web_identity_token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file containing the web identity token.",
+				MarkdownDescription: "The path to a file containing the web identity token. The file is re-read whenever " +
+					"credentials are refreshed. Conflicts with `web_identity_token`. If neither is set, the `" +
+					envWebIdentityTokenFile + "` environment variable is used.",
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"duration": schema.StringAttribute{
+				Optional:    true,
+				Description: "Duration of the assumed role session, e.g. 1h.",
+				MarkdownDescription: "The duration of the assumed role session as a Go duration string, e.g. `1h` or `90m`. " +
+					"Must be between `15m` and `12h`. If not set, the STS default of `1h` is used.",
+			},
+			"policy": schema.StringAttribute{
+				Optional:            true,
+				Description:         "IAM policy JSON further restricting the assumed role session.",
+				MarkdownDescription: "An IAM policy in JSON format that further restricts the permissions of the assumed role session.",
+			},
+		},
+	}
+}
+
+// parseAssumeRoleDuration parses an optional session duration and checks it against the STS limits.
+func parseAssumeRoleDuration(value types.String) (time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", value.ValueString(), err)
+	}
+
+	if d < assumeRoleMinDuration || d > assumeRoleMaxDuration {
+		return 0, fmt.Errorf(
+			"duration %q must be between %s and %s", value.ValueString(), assumeRoleMinDuration, assumeRoleMaxDuration,
+		)
+	}
+
+	return d, nil
+}
+
+func newAssumeRoleCredentials(
+	ctx context.Context, client *sts.Client, config *assumeRoleModel,
+) (aws.CredentialsProvider, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	duration, err := parseAssumeRoleDuration(config.Duration)
+	if err != nil {
+		diags.AddError("Invalid Assume Role Duration", err.Error())
+		return nil, diags
+	}
+
+	tags := map[string]string{}
+	if !config.Tags.IsNull() {
+		diags.Append(config.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	transitiveTagKeys := util.ExpandStringSetOrNil(ctx, config.TransitiveTagKeys, &diags)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	creds := stscreds.NewAssumeRoleProvider(client, config.RoleARN.ValueString(), func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = config.SessionName.ValueString()
+		o.Duration = duration
+		o.ExternalID = util.StringPointerOrNil(config.ExternalID)
+		o.Policy = util.StringPointerOrNil(config.Policy)
+		o.TransitiveTagKeys = transitiveTagKeys
+
+		keys := make([]string, 0, len(tags))
+		for k := range tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			o.Tags = append(o.Tags, ststypes.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+		}
+	})
+
+	return aws.NewCredentialsCache(creds), diags
+}
+
+func newWebIdentityCredentials(
+	client *sts.Client, config *assumeRoleWithWebIdentityModel,
+) (aws.CredentialsProvider, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	duration, err := parseAssumeRoleDuration(config.Duration)
+	if err != nil {
+		diags.AddError("Invalid Assume Role Duration", err.Error())
+		return nil, diags
+	}
+
+	var token stscreds.IdentityTokenRetriever
+	switch {
+	case !config.WebIdentityToken.IsNull():
+		token = staticIdentityToken(config.WebIdentityToken.ValueString())
+	case !config.WebIdentityTokenFile.IsNull():
+		token = stscreds.IdentityTokenFile(config.WebIdentityTokenFile.ValueString())
+	case os.Getenv(envWebIdentityTokenFile) != "":
+		token = stscreds.IdentityTokenFile(os.Getenv(envWebIdentityTokenFile))
+	default:
+		diags.AddError(
+			"Missing Web Identity Token",
+			"The AWS AppStream provider cannot be configured because no web identity token was provided. "+
+				"Set \"assume_role_with_web_identity.web_identity_token\", "+
+				"\"assume_role_with_web_identity.web_identity_token_file\" or the "+envWebIdentityTokenFile+" environment variable.",
+		)
+		return nil, diags
+	}
+
+	creds := stscreds.NewWebIdentityRoleProvider(
+		client, config.RoleARN.ValueString(), token, func(o *stscreds.WebIdentityRoleOptions) {
+			o.RoleSessionName = config.SessionName.ValueString()
+			o.Duration = duration
+			o.Policy = util.StringPointerOrNil(config.Policy)
+		},
+	)
+
+	return aws.NewCredentialsCache(creds), diags
+}
+
+// staticIdentityToken is a web identity token passed directly in the provider configuration.
+type staticIdentityToken string
+
+func (t staticIdentityToken) GetIdentityToken() ([]byte, error) {
+	return []byte(t), nil
+}
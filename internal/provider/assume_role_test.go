@@ -0,0 +1,76 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAssumeRoleDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name:  "null",
+			value: types.StringNull(),
+			want:  0,
+		},
+		{
+			name:  "unknown",
+			value: types.StringUnknown(),
+			want:  0,
+		},
+		{
+			name:  "one_hour",
+			value: types.StringValue("1h"),
+			want:  time.Hour,
+		},
+		{
+			name:  "minimum",
+			value: types.StringValue("15m"),
+			want:  15 * time.Minute,
+		},
+		{
+			name:  "maximum",
+			value: types.StringValue("12h"),
+			want:  12 * time.Hour,
+		},
+		{
+			name:    "too_short",
+			value:   types.StringValue("14m59s"),
+			wantErr: true,
+		},
+		{
+			name:    "too_long",
+			value:   types.StringValue("12h1s"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			value:   types.StringValue("one hour"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAssumeRoleDuration(tt.value)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
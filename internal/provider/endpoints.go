@@ -0,0 +1,67 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+const (
+	envEndpointAppStream                = "AWS_ENDPOINT_URL_APPSTREAM"
+	envEndpointSTS                      = "AWS_ENDPOINT_URL_STS"
+	envEndpointResourceGroupsTaggingAPI = "AWS_ENDPOINT_URL_RESOURCE_GROUPS_TAGGING_API"
+)
+
+type endpointsModel struct {
+	AppStream                types.String `tfsdk:"appstream"`
+	STS                      types.String `tfsdk:"sts"`
+	ResourceGroupsTaggingAPI types.String `tfsdk:"resourcegroupstaggingapi"`
+}
+
+// resolveEndpoints returns the configured endpoints, falling back to the matching environment variables.
+// Values from the environment are validated here since they bypass the schema validators.
+func resolveEndpoints(config *endpointsModel) (metadata.Endpoints, error) {
+	if config == nil {
+		config = &endpointsModel{}
+	}
+
+	var endpoints metadata.Endpoints
+	var err error
+
+	if endpoints.AppStream, err = resolveEndpoint(config.AppStream, envEndpointAppStream); err != nil {
+		return endpoints, err
+	}
+	if endpoints.STS, err = resolveEndpoint(config.STS, envEndpointSTS); err != nil {
+		return endpoints, err
+	}
+	if endpoints.ResourceGroupsTaggingAPI, err = resolveEndpoint(
+		config.ResourceGroupsTaggingAPI, envEndpointResourceGroupsTaggingAPI,
+	); err != nil {
+		return endpoints, err
+	}
+
+	return endpoints, nil
+}
+
+func resolveEndpoint(value types.String, env string) (string, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString(), nil
+	}
+
+	endpoint := os.Getenv(env)
+	if endpoint == "" {
+		return "", nil
+	}
+
+	if err := util.ValidateEndpointURL(endpoint); err != nil {
+		return "", fmt.Errorf("%s: %w", env, err)
+	}
+
+	return endpoint, nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveEndpoints(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *endpointsModel
+		env     map[string]string
+		want    metadata.Endpoints
+		wantErr bool
+	}{
+		{
+			name: "nil_config_no_env",
+			want: metadata.Endpoints{},
+		},
+		{
+			name: "config_only",
+			config: &endpointsModel{
+				AppStream:                types.StringValue("http://localhost:4566"),
+				STS:                      types.StringNull(),
+				ResourceGroupsTaggingAPI: types.StringValue("http://localhost:4567"),
+			},
+			want: metadata.Endpoints{
+				AppStream:                "http://localhost:4566",
+				ResourceGroupsTaggingAPI: "http://localhost:4567",
+			},
+		},
+		{
+			name: "env_fallback",
+			env: map[string]string{
+				envEndpointAppStream:                "http://localhost:4566",
+				envEndpointSTS:                      "http://localhost:4568",
+				envEndpointResourceGroupsTaggingAPI: "http://localhost:4567",
+			},
+			want: metadata.Endpoints{
+				AppStream:                "http://localhost:4566",
+				STS:                      "http://localhost:4568",
+				ResourceGroupsTaggingAPI: "http://localhost:4567",
+			},
+		},
+		{
+			name: "config_takes_precedence_over_env",
+			config: &endpointsModel{
+				AppStream:                types.StringValue("https://appstream.example.com"),
+				STS:                      types.StringNull(),
+				ResourceGroupsTaggingAPI: types.StringNull(),
+			},
+			env: map[string]string{
+				envEndpointAppStream: "http://localhost:4566",
+				envEndpointSTS:       "http://localhost:4568",
+			},
+			want: metadata.Endpoints{
+				AppStream: "https://appstream.example.com",
+				STS:       "http://localhost:4568",
+			},
+		},
+		{
+			name: "invalid_env",
+			env: map[string]string{
+				envEndpointSTS: "localhost:4568",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, env := range []string{envEndpointAppStream, envEndpointSTS, envEndpointResourceGroupsTaggingAPI} {
+				t.Setenv(env, tt.env[env])
+			}
+
+			got, err := resolveEndpoints(tt.config)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
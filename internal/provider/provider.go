@@ -9,13 +9,17 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
 	awsretry "github.com/aws/aws-sdk-go-v2/aws/retry"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	awscredentials "github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -25,47 +29,80 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/app_block"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/app_block_builder"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/app_blocks"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/application"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/applications"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/associate_app_block_builder_app_block"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/associate_application_entitlement"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/associate_application_fleet"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/associate_fleet_stack"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/associate_software_image_builder"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/associate_user_stack"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/directory_config"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/entitlement"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/entitlements"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/fleet"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/fleets"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/image"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/image_builder"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/image_copy"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/image_permissions"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/sessions"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/stack"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/stack_theme"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/stacks"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/streaming_url"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/updated_image"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/usage_report_subscription"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/user"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/users"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/tags"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
 )
 
 var (
-	_ provider.Provider                   = &awsAppStreamProvider{}
-	_ provider.ProviderWithValidateConfig = &awsAppStreamProvider{}
+	_ provider.Provider                       = &awsAppStreamProvider{}
+	_ provider.ProviderWithValidateConfig     = &awsAppStreamProvider{}
+	_ provider.ProviderWithEphemeralResources = &awsAppStreamProvider{}
+	_ provider.ProviderWithActions            = &awsAppStreamProvider{}
 )
 
 type awsAppStreamProvider struct {
 	version string
+	// newMetadata builds the clients handed to resources and data sources.
+	newMetadata func(awscfg aws.Config, endpoints metadata.Endpoints, defaultTags map[string]string) *metadata.Metadata
 }
 
 // awsAppStreamProviderModel describes the provider data model.
 type awsAppStreamProviderModel struct {
-	AccessKey                 types.String      `tfsdk:"access_key"`
-	SecretAccessKey           types.String      `tfsdk:"secret_access_key"`
-	SessionToken              types.String      `tfsdk:"session_token"`
-	Profile                   types.String      `tfsdk:"profile"`
-	SkipCredentialsValidation types.Bool        `tfsdk:"skip_credentials_validation"`
-	Region                    types.String      `tfsdk:"region"`
-	RetryMode                 types.String      `tfsdk:"retry_mode"`
-	RetryMaxAttempts          types.Int64       `tfsdk:"retry_max_attempts"`
-	RetryMaxBackoff           types.Int64       `tfsdk:"retry_max_backoff"`
-	DefaultTags               *defaultTagsModel `tfsdk:"default_tags"`
+	AccessKey                 types.String                    `tfsdk:"access_key"`
+	SecretAccessKey           types.String                    `tfsdk:"secret_access_key"`
+	SessionToken              types.String                    `tfsdk:"session_token"`
+	Profile                   types.String                    `tfsdk:"profile"`
+	SkipCredentialsValidation types.Bool                      `tfsdk:"skip_credentials_validation"`
+	Region                    types.String                    `tfsdk:"region"`
+	RetryMode                 types.String                    `tfsdk:"retry_mode"`
+	RetryMaxAttempts          types.Int64                     `tfsdk:"retry_max_attempts"`
+	RetryMaxBackoff           types.Int64                     `tfsdk:"retry_max_backoff"`
+	DefaultTags               *defaultTagsModel               `tfsdk:"default_tags"`
+	IgnoreTags                *ignoreTagsModel                `tfsdk:"ignore_tags"`
+	Endpoints                 *endpointsModel                 `tfsdk:"endpoints"`
+	AssumeRole                *assumeRoleModel                `tfsdk:"assume_role"`
+	AssumeRoleWithWebIdentity *assumeRoleWithWebIdentityModel `tfsdk:"assume_role_with_web_identity"`
+	AllowedAccountIDs         types.Set                       `tfsdk:"allowed_account_ids"`
+	ForbiddenAccountIDs       types.Set                       `tfsdk:"forbidden_account_ids"`
 }
 
 type defaultTagsModel struct {
 	Tags types.Map `tfsdk:"tags"`
 }
 
+type ignoreTagsModel struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
+}
+
 func (p *awsAppStreamProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.Version = p.version
 	resp.TypeName = "awsappstream"
@@ -185,6 +222,87 @@ Authentication and region selection follow the standard AWS SDK behavior.
 					},
 				},
 			},
+			"ignore_tags": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Tag keys to ignore on all taggable resources managed by this provider.",
+				MarkdownDescription: "Tag keys to ignore on all **taggable** resources managed by this provider, " +
+					"for example tags added by AWS Backup or cost allocation tooling. " +
+					"Matching tags are not read into state and are never added, updated or removed.",
+				Attributes: map[string]schema.Attribute{
+					"keys": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Exact tag keys to ignore.",
+						MarkdownDescription: "A set of exact tag keys to ignore.",
+					},
+					"key_prefixes": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Tag key prefixes to ignore.",
+						MarkdownDescription: "A set of tag key prefixes to ignore. Every tag whose key starts with one of the prefixes is ignored.",
+					},
+				},
+			},
+			"allowed_account_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "AWS account IDs the provider is allowed to manage resources in.",
+				MarkdownDescription: "A set of AWS account IDs the provider is allowed to manage resources in. " +
+					"Configuration fails if the account of the resolved credentials is not part of this set. " +
+					"Requires credential validation. Conflicts with `forbidden_account_ids`.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(accountIDRegex, "must be a 12 digit AWS account ID")),
+					setvalidator.ConflictsWith(path.MatchRoot("forbidden_account_ids")),
+				},
+			},
+			"forbidden_account_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "AWS account IDs the provider must not manage resources in.",
+				MarkdownDescription: "A set of AWS account IDs the provider must not manage resources in. " +
+					"Configuration fails if the account of the resolved credentials is part of this set. " +
+					"Requires credential validation. Conflicts with `allowed_account_ids`.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(accountIDRegex, "must be a 12 digit AWS account ID")),
+				},
+			},
+			"assume_role":                   assumeRoleSchema(),
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"endpoints": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Custom endpoint URLs for the AWS services used by this provider.",
+				MarkdownDescription: "Custom endpoint URLs for the AWS services used by this provider, " +
+					"for example a local mock server, a VPC interface endpoint or a FIPS endpoint. " +
+					"Each URL falls back to its `AWS_ENDPOINT_URL_*` environment variable if unset.",
+				Attributes: map[string]schema.Attribute{
+					"appstream": schema.StringAttribute{
+						Optional:    true,
+						Description: "Custom endpoint URL for AWS AppStream.",
+						MarkdownDescription: "Custom endpoint URL for AWS AppStream. " +
+							"If not set, the `" + envEndpointAppStream + "` environment variable is used, " +
+							"otherwise the AWS SDK default endpoint.",
+						Validators: []validator.String{util.ValidURL()},
+					},
+					"sts": schema.StringAttribute{
+						Optional:    true,
+						Description: "Custom endpoint URL for AWS STS.",
+						MarkdownDescription: "Custom endpoint URL for AWS STS, used to validate credentials. " +
+							"If not set, the `" + envEndpointSTS + "` environment variable is used, " +
+							"otherwise the AWS SDK default endpoint.",
+						Validators: []validator.String{util.ValidURL()},
+					},
+					"resourcegroupstaggingapi": schema.StringAttribute{
+						Optional:    true,
+						Description: "Custom endpoint URL for the AWS Resource Groups Tagging API.",
+						MarkdownDescription: "Custom endpoint URL for the AWS Resource Groups Tagging API, used to manage tags. " +
+							"If not set, the `" + envEndpointResourceGroupsTaggingAPI + "` environment variable is used, " +
+							"otherwise the AWS SDK default endpoint.",
+						Validators: []validator.String{util.ValidURL()},
+					},
+				},
+			},
 		},
 	}
 }
@@ -298,6 +416,147 @@ func (p *awsAppStreamProvider) ValidateConfig(ctx context.Context, req provider.
 		return
 	}
 
+	if config.IgnoreTags != nil && (config.IgnoreTags.Keys.IsUnknown() || config.IgnoreTags.KeyPrefixes.IsUnknown()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ignore_tags"),
+			"Unknown Ignore Tags",
+			"The AWS AppStream provider cannot be configured because \"ignore_tags.keys\" or \"ignore_tags.key_prefixes\" is unknown. "+
+				"Provider configuration values must be static. "+
+				"Set them to fixed values or remove \"ignore_tags\" to ignore no tags.",
+		)
+		return
+	}
+
+	if config.Endpoints != nil {
+		for name, value := range map[string]types.String{
+			"appstream":                config.Endpoints.AppStream,
+			"sts":                      config.Endpoints.STS,
+			"resourcegroupstaggingapi": config.Endpoints.ResourceGroupsTaggingAPI,
+		} {
+			if value.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("endpoints").AtName(name),
+					"Unknown AWS Endpoint",
+					fmt.Sprintf("The AWS AppStream provider cannot be configured because \"endpoints.%s\" is unknown. ", name)+
+						"Provider configuration values must be static. "+
+						"Set it to a fixed URL or remove it to use the default endpoint.",
+				)
+				return
+			}
+		}
+	}
+
+	if config.AssumeRole != nil {
+		for name, value := range map[string]types.String{
+			"role_arn":     config.AssumeRole.RoleARN,
+			"session_name": config.AssumeRole.SessionName,
+			"external_id":  config.AssumeRole.ExternalID,
+			"duration":     config.AssumeRole.Duration,
+			"policy":       config.AssumeRole.Policy,
+		} {
+			if value.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("assume_role").AtName(name),
+					"Unknown Assume Role Configuration",
+					fmt.Sprintf("The AWS AppStream provider cannot be configured because \"assume_role.%s\" is unknown. ", name)+
+						"Provider configuration values must be static.",
+				)
+				return
+			}
+		}
+
+		if config.AssumeRole.Tags.IsUnknown() || config.AssumeRole.TransitiveTagKeys.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("assume_role"),
+				"Unknown Assume Role Configuration",
+				"The AWS AppStream provider cannot be configured because \"assume_role.tags\" or "+
+					"\"assume_role.transitive_tag_keys\" is unknown. Provider configuration values must be static.",
+			)
+			return
+		}
+
+		if _, err := parseAssumeRoleDuration(config.AssumeRole.Duration); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("assume_role").AtName("duration"),
+				"Invalid Assume Role Duration",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if config.AssumeRoleWithWebIdentity != nil {
+		for name, value := range map[string]types.String{
+			"role_arn":                config.AssumeRoleWithWebIdentity.RoleARN,
+			"session_name":            config.AssumeRoleWithWebIdentity.SessionName,
+			"web_identity_token":      config.AssumeRoleWithWebIdentity.WebIdentityToken,
+			"web_identity_token_file": config.AssumeRoleWithWebIdentity.WebIdentityTokenFile,
+			"duration":                config.AssumeRoleWithWebIdentity.Duration,
+			"policy":                  config.AssumeRoleWithWebIdentity.Policy,
+		} {
+			if value.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("assume_role_with_web_identity").AtName(name),
+					"Unknown Assume Role With Web Identity Configuration",
+					fmt.Sprintf(
+						"The AWS AppStream provider cannot be configured because \"assume_role_with_web_identity.%s\" is unknown. ",
+						name,
+					)+"Provider configuration values must be static.",
+				)
+				return
+			}
+		}
+
+		if !config.AssumeRoleWithWebIdentity.WebIdentityToken.IsNull() &&
+			!config.AssumeRoleWithWebIdentity.WebIdentityTokenFile.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("assume_role_with_web_identity"),
+				"Conflicting Web Identity Token Configuration",
+				"The AWS AppStream provider cannot be configured because both \"web_identity_token\" and "+
+					"\"web_identity_token_file\" were provided. Set either one, but not both.",
+			)
+			return
+		}
+
+		if _, err := parseAssumeRoleDuration(config.AssumeRoleWithWebIdentity.Duration); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("assume_role_with_web_identity").AtName("duration"),
+				"Invalid Assume Role Duration",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if config.AllowedAccountIDs.IsUnknown() || config.ForbiddenAccountIDs.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown AWS Account IDs",
+			"The AWS AppStream provider cannot be configured because \"allowed_account_ids\" or \"forbidden_account_ids\" is unknown. "+
+				"Provider configuration values must be static.",
+		)
+		return
+	}
+
+	hasAccountRestriction := !config.AllowedAccountIDs.IsNull() || !config.ForbiddenAccountIDs.IsNull()
+	if hasAccountRestriction && config.SkipCredentialsValidation.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Conflicting AWS Account Configuration",
+			"The AWS AppStream provider cannot be configured because \"allowed_account_ids\" or \"forbidden_account_ids\" "+
+				"requires the account ID of the credentials, which is not resolved when \"skip_credentials_validation\" is set. "+
+				"Remove \"skip_credentials_validation\" or the account restriction.",
+		)
+		return
+	}
+
+	if config.AssumeRole != nil && config.AssumeRoleWithWebIdentity != nil {
+		resp.Diagnostics.AddError(
+			"Conflicting AWS Assume Role Configuration",
+			"The AWS AppStream provider cannot be configured because both \"assume_role\" and "+
+				"\"assume_role_with_web_identity\" were provided. Set either one, but not both.",
+		)
+		return
+	}
+
 	hasAccessKey := !config.AccessKey.IsNull()
 	hasSecretKey := !config.SecretAccessKey.IsNull()
 	hasSession := !config.SessionToken.IsNull()
@@ -431,6 +690,22 @@ func (p *awsAppStreamProvider) Configure(ctx context.Context, req provider.Confi
 		})
 	}
 
+	endpoints, err := resolveEndpoints(config.Endpoints)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid AWS Endpoint",
+			"The AWS AppStream provider cannot be configured because an endpoint URL from the environment is invalid.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Using endpoints", map[string]any{
+		"appstream":                endpoints.AppStream,
+		"sts":                      endpoints.STS,
+		"resourcegroupstaggingapi": endpoints.ResourceGroupsTaggingAPI,
+	})
+
 	tflog.Debug(ctx, "Creating AWS AppStream client")
 
 	awscfg, err := awsconfig.LoadDefaultConfig(ctx, awsopts...)
@@ -444,14 +719,46 @@ func (p *awsAppStreamProvider) Configure(ctx context.Context, req provider.Confi
 		return
 	}
 
+	if config.AssumeRole != nil || config.AssumeRoleWithWebIdentity != nil {
+		stsClient := sts.NewFromConfig(awscfg, func(o *sts.Options) {
+			if endpoints.STS != "" {
+				o.BaseEndpoint = aws.String(endpoints.STS)
+			}
+		})
+
+		var creds aws.CredentialsProvider
+		if config.AssumeRole != nil {
+			ctx = tflog.SetField(ctx, "assume_role_arn", config.AssumeRole.RoleARN.ValueString())
+			tflog.Debug(ctx, "Assuming IAM role")
+			creds, diags = newAssumeRoleCredentials(ctx, stsClient, config.AssumeRole)
+		} else {
+			ctx = tflog.SetField(ctx, "assume_role_arn", config.AssumeRoleWithWebIdentity.RoleARN.ValueString())
+			tflog.Debug(ctx, "Assuming IAM role with web identity")
+			creds, diags = newWebIdentityCredentials(stsClient, config.AssumeRoleWithWebIdentity)
+		}
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		awscfg.Credentials = creds
+	}
+
+	var accountID string
+	partition := metadata.PartitionForRegion(awscfg.Region)
+
 	skipValidation := false
 	if !config.SkipCredentialsValidation.IsNull() && !config.SkipCredentialsValidation.IsUnknown() {
 		skipValidation = config.SkipCredentialsValidation.ValueBool()
 	}
 
 	if !skipValidation {
-		stsClient := sts.NewFromConfig(awscfg)
-		_, err = stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		stsClient := sts.NewFromConfig(awscfg, func(o *sts.Options) {
+			if endpoints.STS != "" {
+				o.BaseEndpoint = aws.String(endpoints.STS)
+			}
+		})
+		identity, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Invalid AWS Credentials",
@@ -459,6 +766,29 @@ func (p *awsAppStreamProvider) Configure(ctx context.Context, req provider.Confi
 			)
 			return
 		}
+
+		accountID = aws.ToString(identity.Account)
+		if callerARN, err := awsarn.Parse(aws.ToString(identity.Arn)); err == nil {
+			partition = callerARN.Partition
+		}
+
+		ctx = tflog.SetField(ctx, "account_id", accountID)
+
+		allowed := util.ExpandStringSetOrNil(ctx, config.AllowedAccountIDs, &resp.Diagnostics)
+		forbidden := util.ExpandStringSetOrNil(ctx, config.ForbiddenAccountIDs, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := checkAccountID(accountID, allowed, forbidden); err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected AWS Account",
+				"The AWS AppStream provider cannot be configured because the credentials belong to an unexpected AWS account. "+
+					"Check \"allowed_account_ids\" and \"forbidden_account_ids\".\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
 	}
 
 	defaultTags := map[string]string{}
@@ -470,10 +800,27 @@ func (p *awsAppStreamProvider) Configure(ctx context.Context, req provider.Confi
 			return
 		}
 	}
-	meta := metadata.NewMetadata(awscfg, defaultTags)
+	var ignoreTags tags.IgnoreTags
+	if config.IgnoreTags != nil {
+		ignoreTags.Keys = util.ExpandStringSetOrNil(ctx, config.IgnoreTags.Keys, &resp.Diagnostics)
+		ignoreTags.KeyPrefixes = util.ExpandStringSetOrNil(ctx, config.IgnoreTags.KeyPrefixes, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	meta := p.newMetadata(awscfg, endpoints, defaultTags)
+	meta.IgnoreTags = ignoreTags
+	meta.Region = awscfg.Region
+	meta.Partition = partition
+	if accountID != "" {
+		meta.AccountID = accountID
+	}
 
 	resp.DataSourceData = meta
 	resp.ResourceData = meta
+	resp.EphemeralResourceData = meta
+	resp.ActionData = meta
 
 	tflog.Info(ctx, "Configured AWS AppStream client", map[string]any{"success": true})
 }
@@ -489,6 +836,15 @@ func (p *awsAppStreamProvider) DataSources(_ context.Context) []func() datasourc
 		user.NewDataSource,
 		image.NewDataSource,
 		image_builder.NewDataSource,
+		app_block_builder.NewDataSource,
+		usage_report_subscription.NewDataSource,
+		sessions.NewDataSource,
+		fleets.NewDataSource,
+		stacks.NewDataSource,
+		users.NewDataSource,
+		app_blocks.NewDataSource,
+		applications.NewDataSource,
+		entitlements.NewDataSource,
 	}
 }
 
@@ -505,12 +861,47 @@ func (p *awsAppStreamProvider) Resources(_ context.Context) []func() resource.Re
 		associate_application_entitlement.NewResource,
 		associate_application_fleet.NewResource,
 		associate_user_stack.NewResource,
+		image.NewResource,
 		image_builder.NewResource,
+		app_block_builder.NewResource,
+		associate_app_block_builder_app_block.NewResource,
+		image_permissions.NewResource,
+		image_copy.NewResource,
+		updated_image.NewResource,
+		usage_report_subscription.NewResource,
+		stack_theme.NewResource,
+		associate_software_image_builder.NewResource,
+	}
+}
+
+func (p *awsAppStreamProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		streaming_url.NewEphemeralResource,
+		image_builder.NewStreamingURLEphemeralResource,
+	}
+}
+
+func (p *awsAppStreamProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		fleet.NewStartAction,
+		fleet.NewStopAction,
+		sessions.NewExpireAction,
+		image_builder.NewStartAction,
+		image_builder.NewStopAction,
 	}
 }
 
 func New(version string) func() provider.Provider {
+	return NewWithMetadata(version, metadata.NewMetadata)
+}
+
+// NewWithMetadata returns a provider that uses newMetadata to build its clients.
+// Tests use it to replace the AWS clients with in-memory fakes.
+func NewWithMetadata(
+	version string,
+	newMetadata func(awscfg aws.Config, endpoints metadata.Endpoints, defaultTags map[string]string) *metadata.Metadata,
+) func() provider.Provider {
 	return func() provider.Provider {
-		return &awsAppStreamProvider{version: version}
+		return &awsAppStreamProvider{version: version, newMetadata: newMetadata}
 	}
 }
@@ -24,6 +24,8 @@ type model struct {
 	PackagingType types.String `tfsdk:"packaging_type"`
 	// Tags is a map of tags assigned to the app block (optional).
 	Tags types.Map `tfsdk:"tags"`
+	// TagsAll is the map of all tags assigned to the app block, including provider default tags (computed).
+	TagsAll types.Map `tfsdk:"tags_all"`
 	// ARN is the ARN of the AppStream app block (computed).
 	ARN types.String `tfsdk:"arn"`
 	// CreatedTime is the timestamp when the app block was created (computed).
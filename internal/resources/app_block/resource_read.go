@@ -96,6 +96,7 @@ func (r *resource) readAppBlock(ctx context.Context, prior model) (*model, diag.
 		PostSetupScriptDetails: flattenScriptDetailsResource(ctx, prior.PostSetupScriptDetails, appBlock.PostSetupScriptDetails, &diags),
 		PackagingType:          util.StringOrNull(aws.String(string(appBlock.PackagingType))),
 		Tags:                   types.MapNull(types.StringType),
+		TagsAll:                types.MapNull(types.StringType),
 		ARN:                    util.StringOrNull(appBlock.Arn),
 		CreatedTime:            util.StringFromTime(appBlock.CreatedTime),
 		State:                  types.StringValue(string(appBlock.State)),
@@ -103,9 +104,10 @@ func (r *resource) readAppBlock(ctx context.Context, prior model) (*model, diag.
 	}
 
 	if !state.ARN.IsNull() {
-		tags, tagDiags := r.tags.Read(ctx, state.ARN.ValueString())
+		tags, tagsAll, tagDiags := r.tags.ReadResource(ctx, state.ARN.ValueString(), prior.Tags)
 		diags.Append(tagDiags...)
 		state.Tags = tags
+		state.TagsAll = tagsAll
 	}
 
 	if diags.HasError() {
@@ -252,6 +252,13 @@ func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *t
 					),
 				},
 			},
+			"tags_all": schema.MapAttribute{
+				Description: "All tags of the AppStream app block, including provider default tags.",
+				MarkdownDescription: "A map of all tags assigned to the AppStream app block, " +
+					"including those inherited from the provider `default_tags`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"arn": schema.StringAttribute{
 				Description:         "ARN of the AppStream app block.",
 				MarkdownDescription: "The Amazon Resource Name (ARN) of the AppStream app block.",
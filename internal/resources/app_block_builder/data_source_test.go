@@ -0,0 +1,67 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_block_builder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccAppBlockBuilderWithDataSource(name, subnetID string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_app_block_builder" "test" {
+  name          = %q
+  platform      = "WINDOWS_SERVER_2019"
+  instance_type = "stream.standard.small"
+
+  description  = "test description"
+  display_name = "Test Builder"
+
+  vpc_config = {
+    subnet_ids = [%q]
+  }
+
+  tags = {
+    Environment = "test"
+  }
+}
+
+data "awsappstream_app_block_builder" "test" {
+  name = awsappstream_app_block_builder.test.name
+}
+`, name, subnetID)
+}
+
+func TestAccAppBlockBuilderDataSource_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-app-block-builder-ds")
+	subnetID := testhelpers.TestAccSubnetID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderWithDataSource(name, subnetID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.awsappstream_app_block_builder.test", "name", name),
+					resource.TestCheckResourceAttr("data.awsappstream_app_block_builder.test", "platform", "WINDOWS_SERVER_2019"),
+					resource.TestCheckResourceAttr("data.awsappstream_app_block_builder.test", "description", "test description"),
+					resource.TestCheckResourceAttr("data.awsappstream_app_block_builder.test", "display_name", "Test Builder"),
+					resource.TestCheckResourceAttr("data.awsappstream_app_block_builder.test", "vpc_config.subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.awsappstream_app_block_builder.test", "tags.Environment", "test"),
+					resource.TestCheckResourceAttrPair(
+						"data.awsappstream_app_block_builder.test", "arn",
+						"awsappstream_app_block_builder.test", "arn",
+					),
+					resource.TestCheckResourceAttrSet("data.awsappstream_app_block_builder.test", "created_time"),
+					resource.TestCheckResourceAttrSet("data.awsappstream_app_block_builder.test", "state"),
+				),
+			},
+		},
+	})
+}
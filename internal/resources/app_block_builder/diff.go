@@ -0,0 +1,44 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_block_builder
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// requiresStoppedAppBlockBuilder reports whether the update changes attributes that aws only
+// accepts while the app block builder is stopped. a running app block builder only accepts
+// display name and description changes.
+// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_UpdateAppBlockBuilder.html
+func requiresStoppedAppBlockBuilder(plan, state resourceModel) bool {
+	changed := func(planValue, stateValue attr.Value) bool {
+		// unknown values are computed by aws and never sent
+		return !planValue.IsUnknown() && !planValue.Equal(stateValue)
+	}
+
+	return changed(plan.InstanceType, state.InstanceType) ||
+		changed(plan.VPCConfig, state.VPCConfig) ||
+		changed(plan.IAMRoleARN, state.IAMRoleARN) ||
+		changed(plan.EnableDefaultInternetAccess, state.EnableDefaultInternetAccess) ||
+		changed(plan.AccessEndpoints, state.AccessEndpoints)
+}
+
+// removesSecurityGroups reports whether the planned vpc config drops all security groups of the current one.
+func removesSecurityGroups(ctx context.Context, plan, state types.Object) bool {
+	if state.IsNull() || state.IsUnknown() {
+		return false
+	}
+
+	var planVPC, stateVPC vpcConfigModel
+	if plan.As(ctx, &planVPC, basetypes.ObjectAsOptions{}).HasError() ||
+		state.As(ctx, &stateVPC, basetypes.ObjectAsOptions{}).HasError() {
+		return false
+	}
+
+	return planVPC.SecurityGroupIDs.IsNull() && !stateVPC.SecurityGroupIDs.IsNull()
+}
@@ -0,0 +1,176 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_block_builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testVPCConfig(securityGroupIDs ...string) types.Object {
+	securityGroups := types.SetNull(types.StringType)
+	if len(securityGroupIDs) > 0 {
+		values := make([]attr.Value, 0, len(securityGroupIDs))
+		for _, id := range securityGroupIDs {
+			values = append(values, types.StringValue(id))
+		}
+		securityGroups = types.SetValueMust(types.StringType, values)
+	}
+
+	return types.ObjectValueMust(
+		vpcConfigObjectType.AttrTypes,
+		map[string]attr.Value{
+			"subnet_ids":         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("subnet-1")}),
+			"security_group_ids": securityGroups,
+		},
+	)
+}
+
+func testAppBlockBuilderModel() resourceModel {
+	return resourceModel{
+		Name:                        types.StringValue("builder"),
+		Platform:                    types.StringValue("WINDOWS_SERVER_2019"),
+		InstanceType:                types.StringValue("stream.standard.small"),
+		Description:                 types.StringNull(),
+		DisplayName:                 types.StringNull(),
+		VPCConfig:                   testVPCConfig(),
+		IAMRoleARN:                  types.StringNull(),
+		EnableDefaultInternetAccess: types.BoolValue(false),
+		AccessEndpoints:             types.SetNull(accessEndpointObjectType),
+		DesiredState:                types.StringNull(),
+		Tags:                        types.MapNull(types.StringType),
+	}
+}
+
+func TestRequiresStoppedAppBlockBuilder(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(plan *resourceModel)
+		want   bool
+	}{
+		{
+			name:   "no_changes",
+			mutate: func(*resourceModel) {},
+			want:   false,
+		},
+		{
+			name: "display_name_and_description_allowed_while_running",
+			mutate: func(plan *resourceModel) {
+				plan.DisplayName = types.StringValue("display")
+				plan.Description = types.StringValue("description")
+			},
+			want: false,
+		},
+		{
+			name: "tags_and_desired_state_allowed_while_running",
+			mutate: func(plan *resourceModel) {
+				plan.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")})
+				plan.DesiredState = types.StringValue("RUNNING")
+			},
+			want: false,
+		},
+		{
+			name: "instance_type_requires_stop",
+			mutate: func(plan *resourceModel) {
+				plan.InstanceType = types.StringValue("stream.standard.large")
+			},
+			want: true,
+		},
+		{
+			name: "vpc_config_requires_stop",
+			mutate: func(plan *resourceModel) {
+				plan.VPCConfig = testVPCConfig("sg-1")
+			},
+			want: true,
+		},
+		{
+			name: "iam_role_requires_stop",
+			mutate: func(plan *resourceModel) {
+				plan.IAMRoleARN = types.StringValue("arn:aws:iam::123456789012:role/builder")
+			},
+			want: true,
+		},
+		{
+			name: "access_endpoints_require_stop",
+			mutate: func(plan *resourceModel) {
+				plan.AccessEndpoints = types.SetValueMust(
+					accessEndpointObjectType,
+					[]attr.Value{
+						types.ObjectValueMust(
+							accessEndpointObjectType.AttrTypes,
+							map[string]attr.Value{
+								"endpoint_type": types.StringValue("STREAMING"),
+								"vpce_id":       types.StringValue("vpce-1"),
+							},
+						),
+					},
+				)
+			},
+			want: true,
+		},
+		{
+			name: "unknown_values_are_ignored",
+			mutate: func(plan *resourceModel) {
+				plan.EnableDefaultInternetAccess = types.BoolUnknown()
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := testAppBlockBuilderModel()
+			plan := testAppBlockBuilderModel()
+			tt.mutate(&plan)
+
+			require.Equal(t, tt.want, requiresStoppedAppBlockBuilder(plan, state))
+		})
+	}
+}
+
+func TestRemovesSecurityGroups(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		plan  types.Object
+		state types.Object
+		want  bool
+	}{
+		{
+			name:  "state_null",
+			plan:  testVPCConfig(),
+			state: types.ObjectNull(vpcConfigObjectType.AttrTypes),
+			want:  false,
+		},
+		{
+			name:  "unchanged",
+			plan:  testVPCConfig("sg-1"),
+			state: testVPCConfig("sg-1"),
+			want:  false,
+		},
+		{
+			name:  "replaced",
+			plan:  testVPCConfig("sg-2"),
+			state: testVPCConfig("sg-1"),
+			want:  false,
+		},
+		{
+			name:  "removed",
+			plan:  testVPCConfig(),
+			state: testVPCConfig("sg-1"),
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, removesSecurityGroups(ctx, tt.plan, tt.state))
+		})
+	}
+}
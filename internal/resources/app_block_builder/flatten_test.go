@@ -0,0 +1,174 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_block_builder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFlattenStateChangeReason(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		in   *awstypes.AppBlockBuilderStateChangeReason
+		want types.Object
+	}{
+		{
+			name: "nil_input",
+			in:   nil,
+			want: types.ObjectNull(stateChangeReasonObjectType.AttrTypes),
+		},
+		{
+			name: "values_set",
+			in: &awstypes.AppBlockBuilderStateChangeReason{
+				Code:    awstypes.AppBlockBuilderStateChangeReasonCodeInternalError,
+				Message: aws.String("boom"),
+			},
+			want: types.ObjectValueMust(
+				stateChangeReasonObjectType.AttrTypes,
+				map[string]attr.Value{
+					"code":    types.StringValue(string(awstypes.AppBlockBuilderStateChangeReasonCodeInternalError)),
+					"message": types.StringValue("boom"),
+				},
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			got := flattenStateChangeReason(ctx, tt.in, &diags)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlattenAppBlockBuilderErrors(t *testing.T) {
+	ctx := context.Background()
+	ts := time.Now()
+
+	tests := []struct {
+		name string
+		in   []awstypes.ResourceError
+		want types.Set
+	}{
+		{
+			name: "empty_slice",
+			in:   nil,
+			want: types.SetNull(appBlockBuilderErrorObjectType),
+		},
+		{
+			name: "single_error",
+			in: []awstypes.ResourceError{
+				{
+					ErrorCode:      awstypes.FleetErrorCodeInternalServiceError,
+					ErrorMessage:   aws.String("boom"),
+					ErrorTimestamp: aws.Time(ts),
+				},
+			},
+			want: types.SetValueMust(
+				appBlockBuilderErrorObjectType,
+				[]attr.Value{
+					types.ObjectValueMust(
+						appBlockBuilderErrorObjectType.AttrTypes,
+						map[string]attr.Value{
+							"error_code":      types.StringValue(string(awstypes.FleetErrorCodeInternalServiceError)),
+							"error_message":   types.StringValue("boom"),
+							"error_timestamp": types.StringValue(ts.Format(time.RFC3339)),
+						},
+					),
+				},
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			got := flattenAppBlockBuilderErrors(ctx, tt.in, &diags)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlattenDesiredState(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior types.String
+		in    awstypes.AppBlockBuilderState
+		want  types.String
+	}{
+		{
+			name:  "prior_null",
+			prior: types.StringNull(),
+			in:    awstypes.AppBlockBuilderStateRunning,
+			want:  types.StringNull(),
+		},
+		{
+			name:  "prior_unknown",
+			prior: types.StringUnknown(),
+			in:    awstypes.AppBlockBuilderStateRunning,
+			want:  types.StringUnknown(),
+		},
+		{
+			name:  "running",
+			prior: types.StringValue("RUNNING"),
+			in:    awstypes.AppBlockBuilderStateRunning,
+			want:  types.StringValue("RUNNING"),
+		},
+		{
+			name:  "starting_counts_as_running",
+			prior: types.StringValue("RUNNING"),
+			in:    awstypes.AppBlockBuilderStateStarting,
+			want:  types.StringValue("RUNNING"),
+		},
+		{
+			name:  "stopping_counts_as_stopped",
+			prior: types.StringValue("RUNNING"),
+			in:    awstypes.AppBlockBuilderStateStopping,
+			want:  types.StringValue("STOPPED"),
+		},
+		{
+			name:  "drift_detected",
+			prior: types.StringValue("STOPPED"),
+			in:    awstypes.AppBlockBuilderStateRunning,
+			want:  types.StringValue("RUNNING"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := flattenDesiredState(tt.prior, tt.in)
+
+			if !got.Equal(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
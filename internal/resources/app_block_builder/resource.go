@@ -0,0 +1,90 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_block_builder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/tags"
+)
+
+var (
+	_ tfresource.Resource                = &resource{}
+	_ tfresource.ResourceWithConfigure   = &resource{}
+	_ tfresource.ResourceWithImportState = &resource{}
+	_ tfresource.ResourceWithModifyPlan  = &resource{}
+)
+
+func NewResource() tfresource.Resource {
+	return &resource{}
+}
+
+type resource struct {
+	appstreamClient metadata.AppStreamAPI
+	tags            *tags.TagManager
+}
+
+func (r *resource) Metadata(_ context.Context, req tfresource.MetadataRequest, resp *tfresource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_block_builder"
+}
+
+func (r *resource) Configure(_ context.Context, req tfresource.ConfigureRequest, resp *tfresource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*metadata.Metadata)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Metadata, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if meta.Appstream == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *Metadata.Appstream, got: nil. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	if meta.Tagging == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *Metadata.Tagging, got: nil. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.appstreamClient = meta.Appstream
+	r.tags = tags.NewTagManager(meta.Tagging, meta.DefaultTags, meta.IgnoreTags)
+}
+
+func (r *resource) ImportState(ctx context.Context, req tfresource.ImportStateRequest, resp *tfresource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier format: <app_block_builder_name>",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func (r *resource) ModifyPlan(ctx context.Context, req tfresource.ModifyPlanRequest, resp *tfresource.ModifyPlanResponse) {
+	// provider not configured yet
+	if r.tags == nil {
+		return
+	}
+
+	r.tags.ModifyPlan(ctx, req, resp)
+}
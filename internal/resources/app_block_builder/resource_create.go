@@ -0,0 +1,142 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_block_builder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	var plan resourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	if plan.Name.IsNull() || plan.Name.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Plan",
+			"Cannot create app block builder because name must be known.",
+		)
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	input := &awsappstream.CreateAppBlockBuilderInput{
+		Name:         aws.String(name),
+		Platform:     awstypes.AppBlockBuilderPlatformType(plan.Platform.ValueString()),
+		InstanceType: aws.String(plan.InstanceType.ValueString()),
+	}
+
+	input.Description = util.StringPointerOrNil(plan.Description)
+	input.DisplayName = util.StringPointerOrNil(plan.DisplayName)
+
+	if !plan.VPCConfig.IsNull() && !plan.VPCConfig.IsUnknown() {
+		input.VpcConfig = expandVPCConfig(ctx, plan.VPCConfig, &resp.Diagnostics)
+	}
+
+	input.IamRoleArn = util.StringPointerOrNil(plan.IAMRoleARN)
+	input.EnableDefaultInternetAccess = util.BoolPointerOrNil(plan.EnableDefaultInternetAccess)
+
+	if !plan.AccessEndpoints.IsNull() && !plan.AccessEndpoints.IsUnknown() {
+		input.AccessEndpoints = expandAccessEndpoints(ctx, plan.AccessEndpoints, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var out *awsappstream.CreateAppBlockBuilderOutput
+	err := util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			var err error
+			out, err = r.appstreamClient.CreateAppBlockBuilder(ctx, input)
+			return err
+		},
+		util.WithTimeout(createRetryTimeout),
+		util.WithInitBackoff(createRetryInitBackoff),
+		util.WithMaxBackoff(createRetryMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_CreateAppBlockBuilder.html
+		util.WithRetryOnFns(
+			util.IsConcurrentModificationException,
+			util.IsOperationNotPermittedException,
+			util.IsResourceNotAvailableException,
+			util.IsResourceNotFoundException,
+		),
+	)
+
+	if err != nil {
+		if util.IsResourceAlreadyExists(err) {
+			resp.Diagnostics.AddError(
+				"AWS AppStream App Block Builder Already Exists",
+				fmt.Sprintf(
+					"An app block builder named %q already exists. To manage it with Terraform, import it using:\n\n"+
+						"  terraform import <resource_address> %q",
+					name, name,
+				),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating AWS AppStream App Block Builder",
+			fmt.Sprintf("Could not create app block builder %q: %v", name, err),
+		)
+		return
+	}
+
+	if out.AppBlockBuilder != nil && out.AppBlockBuilder.Arn != nil {
+		_, tagDiags := r.tags.Apply(ctx, aws.ToString(out.AppBlockBuilder.Arn), plan.Tags)
+		resp.Diagnostics.Append(tagDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// start and stop are only accepted once the app block builder left its transitional state
+	err = r.waitAppBlockBuilderSettled(ctx, name)
+	if err == nil && !plan.DesiredState.IsNull() && !plan.DesiredState.IsUnknown() {
+		err = r.ensureAppBlockBuilderState(ctx, name, awstypes.AppBlockBuilderState(plan.DesiredState.ValueString()))
+	}
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Waiting for AWS AppStream App Block Builder",
+			fmt.Sprintf("App block builder %q did not reach the desired state: %v", name, err),
+		)
+		// keep the app block builder in state so terraform taints it and deletes it on the next apply
+	}
+
+	newState, diags := r.readAppBlockBuilder(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_block_builder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var ErrUnexpectedAppBlockBuilderState = errors.New("unexpected app block builder state")
+
+func (r *resource) describeAppBlockBuilderState(
+	ctx context.Context, name string,
+) (awstypes.AppBlockBuilderState, error) {
+	out, err := r.appstreamClient.DescribeAppBlockBuilders(ctx, &awsappstream.DescribeAppBlockBuildersInput{
+		Names: []string{name},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(out.AppBlockBuilders) == 0 {
+		return "", fmt.Errorf("app block builder %q not found", name)
+	}
+
+	return out.AppBlockBuilders[0].State, nil
+}
+
+// waitAppBlockBuilderSettled waits until the app block builder is neither starting nor stopping.
+func (r *resource) waitAppBlockBuilderSettled(ctx context.Context, name string) error {
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			state, err := r.describeAppBlockBuilderState(ctx, name)
+			if err != nil {
+				return err
+			}
+
+			switch state {
+			case awstypes.AppBlockBuilderStateRunning, awstypes.AppBlockBuilderStateStopped:
+				return nil
+			default:
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedAppBlockBuilderState, state)
+			}
+		},
+		util.WithTimeout(appBlockBuilderWaitTimeout),
+		util.WithInitBackoff(appBlockBuilderWaitInitBackoff),
+		util.WithMaxBackoff(appBlockBuilderWaitMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeAppBlockBuilders.html
+		util.WithRetryOnFns(
+			func(err error) bool {
+				return errors.Is(err, ErrUnexpectedAppBlockBuilderState)
+			},
+		),
+	)
+}
+
+// restoreRunningAppBlockBuilder starts an app block builder that was stopped for an update which did not
+// complete, so that a failed update does not leave a previously running app block builder stopped.
+func (r *resource) restoreRunningAppBlockBuilder(ctx context.Context, name string, diags *diag.Diagnostics) {
+	err := r.ensureAppBlockBuilderState(ctx, name, awstypes.AppBlockBuilderStateRunning)
+	if err == nil || util.IsContextCanceled(err) {
+		return
+	}
+
+	diags.AddError(
+		"Error Restoring AWS AppStream App Block Builder State",
+		fmt.Sprintf("Could not start app block builder %q again after the failed update: %v", name, err),
+	)
+}
+
+// ensureAppBlockBuilderState starts or stops the app block builder and waits until it reaches the target state.
+func (r *resource) ensureAppBlockBuilderState(
+	ctx context.Context, name string, target awstypes.AppBlockBuilderState,
+) error {
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			state, err := r.describeAppBlockBuilderState(ctx, name)
+			if err != nil {
+				return err
+			}
+
+			if state == target {
+				return nil
+			}
+
+			switch state {
+			case awstypes.AppBlockBuilderStateStopped:
+				// startable state
+				_, err = r.appstreamClient.StartAppBlockBuilder(ctx, &awsappstream.StartAppBlockBuilderInput{
+					Name: aws.String(name),
+				})
+				if err != nil {
+					return err
+				}
+				// retry as we just started the app block builder
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedAppBlockBuilderState, state)
+
+			case awstypes.AppBlockBuilderStateRunning:
+				// stoppable state
+				_, err = r.appstreamClient.StopAppBlockBuilder(ctx, &awsappstream.StopAppBlockBuilderInput{
+					Name: aws.String(name),
+				})
+				if err != nil {
+					return err
+				}
+				// retry as we just stopped the app block builder
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedAppBlockBuilderState, state)
+
+			default:
+				// wait for starting or stopping to settle
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedAppBlockBuilderState, state)
+			}
+		},
+		util.WithTimeout(appBlockBuilderWaitTimeout),
+		util.WithInitBackoff(appBlockBuilderWaitInitBackoff),
+		util.WithMaxBackoff(appBlockBuilderWaitMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeAppBlockBuilders.html
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_StartAppBlockBuilder.html
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_StopAppBlockBuilder.html
+		util.WithRetryOnFns(
+			func(err error) bool {
+				return errors.Is(err, ErrUnexpectedAppBlockBuilderState)
+			},
+			util.IsConcurrentModificationException,
+			util.IsOperationNotPermittedException,
+		),
+	)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_block_builder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccAppBlockBuilderBasicConfig(name, subnetID string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_app_block_builder" "test" {
+  name          = %q
+  platform      = "WINDOWS_SERVER_2019"
+  instance_type = "stream.standard.small"
+
+  vpc_config = {
+    subnet_ids = [%q]
+  }
+}
+`, name, subnetID)
+}
+
+func TestAccAppBlockBuilder_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-app-block-builder")
+	resourceName := "awsappstream_app_block_builder.test"
+	subnetID := testhelpers.TestAccSubnetID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderBasicConfig(name, subnetID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "platform", "WINDOWS_SERVER_2019"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.small"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.subnet_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "enable_default_internet_access"),
+					resource.TestCheckNoResourceAttr(resourceName, "tags"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "state"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAppBlockBuilderUpdateConfig(name, subnetID, instanceType, description string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_app_block_builder" "test" {
+  name          = %q
+  platform      = "WINDOWS_SERVER_2019"
+  instance_type = %q
+
+  description  = %q
+  display_name = "Test Builder"
+
+  vpc_config = {
+    subnet_ids = [%q]
+  }
+
+  tags = {
+    Environment = "test"
+  }
+}
+`, name, instanceType, description, subnetID)
+}
+
+func TestAccAppBlockBuilder_update(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-app-block-builder-update")
+	resourceName := "awsappstream_app_block_builder.test"
+	subnetID := testhelpers.TestAccSubnetID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderUpdateConfig(name, subnetID, "stream.standard.small", "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.small"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Test Builder"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "test"),
+				),
+			},
+			{
+				Config: testAccAppBlockBuilderUpdateConfig(name, subnetID, "stream.standard.medium", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.medium"),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAppBlockBuilderDesiredStateConfig(name, subnetID, desiredState string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_app_block_builder" "test" {
+  name          = %q
+  platform      = "WINDOWS_SERVER_2019"
+  instance_type = "stream.standard.small"
+
+  vpc_config = {
+    subnet_ids = [%q]
+  }
+
+  desired_state = %q
+}
+`, name, subnetID, desiredState)
+}
+
+func TestAccAppBlockBuilder_desiredState(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-app-block-builder-state")
+	resourceName := "awsappstream_app_block_builder.test"
+	subnetID := testhelpers.TestAccSubnetID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderDesiredStateConfig(name, subnetID, "RUNNING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+				),
+			},
+			{
+				Config: testAccAppBlockBuilderDesiredStateConfig(name, subnetID, "STOPPED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+				),
+			},
+		},
+	})
+}
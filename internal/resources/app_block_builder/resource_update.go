@@ -0,0 +1,223 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_block_builder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Update(ctx context.Context, req tfresource.UpdateRequest, resp *tfresource.UpdateResponse) {
+	var plan resourceModel
+	var state resourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	if plan.Name.IsNull() || plan.Name.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Plan",
+			"Cannot update app block builder because name must be known.",
+		)
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	// guard against unexpected identity drift
+	if !state.Name.IsNull() && !state.Name.IsUnknown() {
+		if state.Name.ValueString() != name {
+			resp.Diagnostics.AddError(
+				"Unexpected Update Request",
+				"App block builder identity (name) changed during update. This should trigger replacement. Please report this issue.",
+			)
+			return
+		}
+	}
+
+	input := &awsappstream.UpdateAppBlockBuilderInput{
+		Name: aws.String(name),
+	}
+
+	var attrsToDelete []awstypes.AppBlockBuilderAttribute
+
+	util.OptionalStringUpdate(plan.DisplayName, state.DisplayName, func(v *string) {
+		input.DisplayName = v
+	})
+
+	util.OptionalStringUpdate(plan.Description, state.Description, func(v *string) {
+		input.Description = v
+	})
+
+	// a running app block builder rejects every other attribute, so only send them
+	// when the app block builder is going to be stopped for the update
+	stopRequired := requiresStoppedAppBlockBuilder(plan, state)
+	if stopRequired {
+		util.OptionalStringUpdate(plan.InstanceType, state.InstanceType, func(v *string) {
+			input.InstanceType = v
+		})
+
+		if plan.IAMRoleARN.IsNull() {
+			if !state.IAMRoleARN.IsNull() {
+				attrsToDelete = append(attrsToDelete, awstypes.AppBlockBuilderAttributeIamRoleArn)
+			}
+		} else if !plan.IAMRoleARN.IsUnknown() {
+			input.IamRoleArn = plan.IAMRoleARN.ValueStringPointer()
+		}
+
+		if plan.EnableDefaultInternetAccess.IsNull() {
+			// no delete support
+		} else if !plan.EnableDefaultInternetAccess.IsUnknown() {
+			input.EnableDefaultInternetAccess = plan.EnableDefaultInternetAccess.ValueBoolPointer()
+		}
+
+		if !plan.VPCConfig.IsNull() && !plan.VPCConfig.IsUnknown() {
+			input.VpcConfig = expandVPCConfig(ctx, plan.VPCConfig, &resp.Diagnostics)
+
+			if removesSecurityGroups(ctx, plan.VPCConfig, state.VPCConfig) {
+				attrsToDelete = append(attrsToDelete, awstypes.AppBlockBuilderAttributeVpcConfigurationSecurityGroupIds)
+			}
+		}
+
+		if plan.AccessEndpoints.IsNull() {
+			if !state.AccessEndpoints.IsNull() {
+				attrsToDelete = append(attrsToDelete, awstypes.AppBlockBuilderAttributeAccessEndpoints)
+			}
+		} else if !plan.AccessEndpoints.IsUnknown() {
+			input.AccessEndpoints = expandAccessEndpoints(ctx, plan.AccessEndpoints, &resp.Diagnostics)
+		}
+	}
+
+	input.AttributesToDelete = attrsToDelete
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// stop the app block builder first and restore its previous running state once the update is done,
+	// also if the update fails.
+	restoreRunning := false
+	defer func() {
+		if restoreRunning {
+			r.restoreRunningAppBlockBuilder(ctx, name, &resp.Diagnostics)
+		}
+	}()
+	if stopRequired {
+		current, err := r.describeAppBlockBuilderState(ctx, name)
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			if util.IsAppStreamNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Reading AWS AppStream App Block Builder State",
+				fmt.Sprintf("Could not read state of app block builder %q: %v", name, err),
+			)
+			return
+		}
+
+		if current != awstypes.AppBlockBuilderStateStopped {
+			err = r.ensureAppBlockBuilderState(ctx, name, awstypes.AppBlockBuilderStateStopped)
+			if err != nil {
+				if util.IsContextCanceled(err) {
+					return
+				}
+
+				resp.Diagnostics.AddError(
+					"Error Stopping AWS AppStream App Block Builder",
+					fmt.Sprintf("Could not stop app block builder %q before update: %v", name, err),
+				)
+				return
+			}
+			restoreRunning = current == awstypes.AppBlockBuilderStateRunning ||
+				current == awstypes.AppBlockBuilderStateStarting
+		}
+	}
+
+	out, err := r.appstreamClient.UpdateAppBlockBuilder(ctx, input)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		if util.IsAppStreamNotFound(err) {
+			restoreRunning = false
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating AWS AppStream App Block Builder",
+			fmt.Sprintf("Could not update app block builder %q: %v", name, err),
+		)
+		return
+	}
+
+	if out.AppBlockBuilder != nil && out.AppBlockBuilder.Arn != nil {
+		_, tagDiags := r.tags.Apply(ctx, aws.ToString(out.AppBlockBuilder.Arn), plan.Tags)
+		resp.Diagnostics.Append(tagDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	desiredState := ""
+	switch {
+	case !plan.DesiredState.IsNull() && !plan.DesiredState.IsUnknown():
+		desiredState = plan.DesiredState.ValueString()
+	case restoreRunning:
+		desiredState = string(awstypes.AppBlockBuilderStateRunning)
+	}
+	// the desired state takes over from the deferred restore
+	restoreRunning = false
+
+	if desiredState != "" {
+		err = r.ensureAppBlockBuilderState(ctx, name, awstypes.AppBlockBuilderState(desiredState))
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Changing AWS AppStream App Block Builder State",
+				fmt.Sprintf("Could not change state of app block builder %q to %s: %v", name, desiredState, err),
+			)
+			return
+		}
+	}
+
+	newState, diags := r.readAppBlockBuilder(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
@@ -0,0 +1,16 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_block_builder
+
+import "time"
+
+const (
+	createRetryTimeout     = 15 * time.Minute
+	createRetryInitBackoff = 10 * time.Second
+	createRetryMaxBackoff  = 2 * time.Minute
+
+	appBlockBuilderWaitTimeout     = 45 * time.Minute
+	appBlockBuilderWaitInitBackoff = 30 * time.Second
+	appBlockBuilderWaitMaxBackoff  = 1 * time.Minute
+)
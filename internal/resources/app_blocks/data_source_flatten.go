@@ -0,0 +1,70 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_blocks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var appBlockObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":           types.StringType,
+		"arn":            types.StringType,
+		"display_name":   types.StringType,
+		"description":    types.StringType,
+		"packaging_type": types.StringType,
+		"state":          types.StringType,
+		"created_time":   types.StringType,
+		"tags":           types.MapType{ElemType: types.StringType},
+	},
+}
+
+// flattenAppBlocks returns the names, ARNs and key attributes of app blocks. Empty results are empty lists, not null.
+func flattenAppBlocks(
+	ctx context.Context, appBlocks []awstypes.AppBlock, tagsByARN map[string]types.Map, diags *diag.Diagnostics,
+) (names types.List, arns types.List, appBlockList types.List) {
+	nameValues := make([]string, 0, len(appBlocks))
+	arnValues := make([]string, 0, len(appBlocks))
+	models := make([]appBlockModel, 0, len(appBlocks))
+
+	for _, appBlock := range appBlocks {
+		arn := aws.ToString(appBlock.Arn)
+
+		appBlockTags, ok := tagsByARN[arn]
+		if !ok {
+			appBlockTags = types.MapNull(types.StringType)
+		}
+
+		nameValues = append(nameValues, aws.ToString(appBlock.Name))
+		arnValues = append(arnValues, arn)
+		models = append(models, appBlockModel{
+			Name:          util.StringOrNull(appBlock.Name),
+			ARN:           util.StringOrNull(appBlock.Arn),
+			DisplayName:   util.StringOrNull(appBlock.DisplayName),
+			Description:   util.StringOrNull(appBlock.Description),
+			PackagingType: types.StringValue(string(appBlock.PackagingType)),
+			State:         types.StringValue(string(appBlock.State)),
+			CreatedTime:   util.StringFromTime(appBlock.CreatedTime),
+			Tags:          appBlockTags,
+		})
+	}
+
+	names, d := types.ListValueFrom(ctx, types.StringType, nameValues)
+	diags.Append(d...)
+
+	arns, d = types.ListValueFrom(ctx, types.StringType, arnValues)
+	diags.Append(d...)
+
+	appBlockList, d = types.ListValueFrom(ctx, appBlockObjectType, models)
+	diags.Append(d...)
+
+	return names, arns, appBlockList
+}
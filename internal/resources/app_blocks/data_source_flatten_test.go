@@ -0,0 +1,70 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_blocks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenAppBlocks(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty_is_not_null", func(t *testing.T) {
+		var diags diag.Diagnostics
+		names, arns, appBlocks := flattenAppBlocks(ctx, nil, nil, &diags)
+		require.False(t, diags.HasError())
+		require.False(t, names.IsNull())
+		require.Empty(t, names.Elements())
+		require.False(t, arns.IsNull())
+		require.Empty(t, arns.Elements())
+		require.False(t, appBlocks.IsNull())
+		require.Empty(t, appBlocks.Elements())
+	})
+
+	t.Run("tags_are_attached_by_arn", func(t *testing.T) {
+		prodTags := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"env": types.StringValue("prod"),
+		})
+
+		var diags diag.Diagnostics
+		names, arns, appBlocks := flattenAppBlocks(ctx, []awstypes.AppBlock{
+			{
+				Name:          aws.String("a"),
+				Arn:           aws.String("arn:a"),
+				PackagingType: awstypes.PackagingTypeAppstream2,
+				State:         awstypes.AppBlockStateActive,
+			},
+			{
+				Name:          aws.String("b"),
+				Arn:           aws.String("arn:b"),
+				PackagingType: awstypes.PackagingTypeCustom,
+				State:         awstypes.AppBlockStateInactive,
+			},
+		}, map[string]types.Map{"arn:a": prodTags}, &diags)
+		require.False(t, diags.HasError())
+
+		require.Equal(t, []attr.Value{types.StringValue("a"), types.StringValue("b")}, names.Elements())
+		require.Equal(t, []attr.Value{types.StringValue("arn:a"), types.StringValue("arn:b")}, arns.Elements())
+
+		var models []appBlockModel
+		diags.Append(appBlocks.ElementsAs(ctx, &models, false)...)
+		require.False(t, diags.HasError())
+		require.Len(t, models, 2)
+
+		require.Equal(t, "APPSTREAM2", models[0].PackagingType.ValueString())
+		require.Equal(t, "ACTIVE", models[0].State.ValueString())
+		require.True(t, models[0].Tags.Equal(prodTags))
+
+		require.Equal(t, "CUSTOM", models[1].PackagingType.ValueString())
+		require.True(t, models[1].Tags.IsNull())
+	})
+}
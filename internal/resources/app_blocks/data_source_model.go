@@ -0,0 +1,32 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_blocks
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type dataSourceModel struct {
+	// ID is a synthetic identifier equal to the configured region.
+	ID types.String `tfsdk:"id"`
+	// NameRegex only selects app blocks whose name matches the regular expression (optional).
+	NameRegex types.String `tfsdk:"name_regex"`
+	// Tags only selects app blocks carrying all of these tags (optional).
+	Tags types.Map `tfsdk:"tags"`
+	// Names are the names of the matching app blocks (computed).
+	Names types.List `tfsdk:"names"`
+	// ARNs are the ARNs of the matching app blocks (computed).
+	ARNs types.List `tfsdk:"arns"`
+	// AppBlocks are the key attributes of the matching app blocks (computed).
+	AppBlocks types.List `tfsdk:"app_blocks"`
+}
+
+type appBlockModel struct {
+	Name          types.String `tfsdk:"name"`
+	ARN           types.String `tfsdk:"arn"`
+	DisplayName   types.String `tfsdk:"display_name"`
+	Description   types.String `tfsdk:"description"`
+	PackagingType types.String `tfsdk:"packaging_type"`
+	State         types.String `tfsdk:"state"`
+	CreatedTime   types.String `tfsdk:"created_time"`
+	Tags          types.Map    `tfsdk:"tags"`
+}
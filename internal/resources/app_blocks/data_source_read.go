@@ -0,0 +1,107 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_blocks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/tags"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+// appBlockResourceType is the Resource Groups Tagging resource type of AppStream app blocks.
+const appBlockResourceType = "appstream:app-block"
+
+func (ds *dataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config dataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	regex, err := util.RegexpOrNil(config.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Configuration",
+			fmt.Sprintf("Could not compile name_regex: %v", err),
+		)
+		return
+	}
+
+	appBlocks, err := ds.listAppBlocks(ctx, regex)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading AWS AppStream App Blocks",
+			fmt.Sprintf("Could not list app blocks: %v", err),
+		)
+		return
+	}
+
+	appBlocks, tagsByARN, diags := tags.FilterByResourceType(
+		ctx, ds.tags, appBlockResourceType, config.Tags, appBlocks, func(b awstypes.AppBlock) *string { return b.Arn },
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(ds.region)
+	config.Names, config.ARNs, config.AppBlocks = flattenAppBlocks(ctx, appBlocks, tagsByARN, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listAppBlocks pages through DescribeAppBlocks and returns the app blocks matching regex, sorted by name.
+func (ds *dataSource) listAppBlocks(ctx context.Context, regex *regexp.Regexp) ([]awstypes.AppBlock, error) {
+	var out []awstypes.AppBlock
+	var nextToken *string
+
+	for {
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeAppBlocks.html
+		resp, err := ds.appstreamClient.DescribeAppBlocks(ctx, &awsappstream.DescribeAppBlocksInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, appBlock := range resp.AppBlocks {
+			if regex != nil && !regex.MatchString(aws.ToString(appBlock.Name)) {
+				continue
+			}
+			out = append(out, appBlock)
+		}
+
+		if resp.NextToken == nil || *resp.NextToken == "" {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return aws.ToString(out[i].Name) < aws.ToString(out[j].Name)
+	})
+
+	return out, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_blocks
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (ds *dataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List AWS AppStream App Blocks",
+		MarkdownDescription: "Lists the AppStream app blocks of the configured region. " +
+			"All filters are optional and combined, so only app blocks matching every configured filter are returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the AppStream app blocks.",
+				MarkdownDescription: "A synthetic identifier for the app blocks, equal to the configured region.",
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression to match app block names.",
+				MarkdownDescription: "A regular expression used to match AppStream app block names. " +
+					"Uses Go regular expression syntax.",
+				Optional: true,
+				Validators: []validator.String{
+					util.ValidRegex(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of the AppStream app blocks.",
+				MarkdownDescription: "Only return app blocks carrying all of these tags. " +
+					"Tags are resolved through the Resource Groups Tagging API.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"names": schema.ListAttribute{
+				Description:         "Names of the AppStream app blocks.",
+				MarkdownDescription: "The names of the matching app blocks, sorted by name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"arns": schema.ListAttribute{
+				Description:         "ARNs of the AppStream app blocks.",
+				MarkdownDescription: "The ARNs of the matching app blocks, in the same order as `names`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"app_blocks": schema.ListNestedAttribute{
+				Description:         "AppStream app blocks.",
+				MarkdownDescription: "The key attributes of the matching app blocks, in the same order as `names`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description:         "Name of the app block.",
+							MarkdownDescription: "The name of the app block.",
+							Computed:            true,
+						},
+						"arn": schema.StringAttribute{
+							Description:         "ARN of the app block.",
+							MarkdownDescription: "The ARN of the app block.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							Description:         "Display name of the app block.",
+							MarkdownDescription: "The display name of the AppStream app block, if set.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							Description:         "Description of the app block.",
+							MarkdownDescription: "The app block description, if set.",
+							Computed:            true,
+						},
+						"packaging_type": schema.StringAttribute{
+							Description:         "Packaging type of the app block.",
+							MarkdownDescription: "The packaging type of the app block.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							Description:         "State of the AppStream app block.",
+							MarkdownDescription: "The state of the AppStream app block.",
+							Computed:            true,
+						},
+						"created_time": schema.StringAttribute{
+							Description:         "Time the app block was created.",
+							MarkdownDescription: "The timestamp when the app block was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"tags": schema.MapAttribute{
+							Description:         "Tags applied to the app block.",
+							MarkdownDescription: "Tags assigned to the AppStream app block.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
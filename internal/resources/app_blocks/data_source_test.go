@@ -0,0 +1,44 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package app_blocks_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+// app blocks need packaged S3 artifacts, so only the listing and filtering itself is covered here
+func testAccAppBlocksDataSourceConfig() string {
+	return testhelpers.TestAccProviderBasicConfig() + `
+data "awsappstream_app_blocks" "all" {}
+
+data "awsappstream_app_blocks" "no_match" {
+  name_regex = "^tf-acc-app-blocks-ds-missing-"
+
+  tags = {
+    TestName = "tf-acc-app-blocks-ds-missing"
+  }
+}
+`
+}
+
+func TestAccAppBlocksDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlocksDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.awsappstream_app_blocks.all", "id"),
+					resource.TestCheckResourceAttrSet("data.awsappstream_app_blocks.all", "names.#"),
+					resource.TestCheckResourceAttr("data.awsappstream_app_blocks.no_match", "names.#", "0"),
+					resource.TestCheckResourceAttr("data.awsappstream_app_blocks.no_match", "app_blocks.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/tags"
@@ -23,7 +22,7 @@ func NewDataSource() datasource.DataSource {
 }
 
 type dataSource struct {
-	appstreamClient *awsappstream.Client
+	appstreamClient metadata.AppStreamAPI
 	tags            *tags.TagManager
 }
 
@@ -62,5 +61,5 @@ func (ds *dataSource) Configure(_ context.Context, req datasource.ConfigureReque
 	}
 
 	ds.appstreamClient = meta.Appstream
-	ds.tags = tags.NewTagManager(meta.Tagging, meta.DefaultTags)
+	ds.tags = tags.NewTagManager(meta.Tagging, meta.DefaultTags, meta.IgnoreTags)
 }
@@ -30,6 +30,8 @@ type model struct {
 	AppBlockARN types.String `tfsdk:"app_block_arn"`
 	// Tags is a map of tags to assign to the application (optional).
 	Tags types.Map `tfsdk:"tags"`
+	// TagsAll is the map of all tags assigned to the application, including provider default tags (computed).
+	TagsAll types.Map `tfsdk:"tags_all"`
 	// ARN is the ARN of the AppStream application (computed).
 	ARN types.String `tfsdk:"arn"`
 	// CreatedTime is the timestamp when the application was created (computed).
@@ -110,7 +110,7 @@ func (r *resource) Create(ctx context.Context, req tfresource.CreateRequest, res
 		}
 	}
 
-	newState, diags := r.readApplication(ctx, aws.ToString(out.Application.Arn))
+	newState, diags := r.readApplication(ctx, aws.ToString(out.Application.Arn), plan.Tags)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -38,7 +38,7 @@ func (r *resource) Read(ctx context.Context, req tfresource.ReadRequest, resp *t
 
 	arn := state.ID.ValueString()
 
-	newState, diags := r.readApplication(ctx, arn)
+	newState, diags := r.readApplication(ctx, arn, state.Tags)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -55,7 +55,9 @@ func (r *resource) Read(ctx context.Context, req tfresource.ReadRequest, resp *t
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
 
-func (r *resource) readApplication(ctx context.Context, arn string) (*model, diag.Diagnostics) {
+func (r *resource) readApplication(
+	ctx context.Context, arn string, priorTags types.Map,
+) (*model, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	out, err := r.appstreamClient.DescribeApplications(ctx, &awsappstream.DescribeApplicationsInput{
@@ -99,14 +101,16 @@ func (r *resource) readApplication(ctx context.Context, arn string) (*model, dia
 		InstanceFamilies: util.SetStringOrNull(ctx, app.InstanceFamilies, &diags),
 		AppBlockARN:      util.StringOrNull(app.AppBlockArn),
 		Tags:             types.MapNull(types.StringType),
+		TagsAll:          types.MapNull(types.StringType),
 		ARN:              util.StringOrNull(app.Arn),
 		CreatedTime:      util.StringFromTime(app.CreatedTime),
 	}
 
 	if !state.ARN.IsNull() {
-		tags, tagDiags := r.tags.Read(ctx, state.ARN.ValueString())
+		tags, tagsAll, tagDiags := r.tags.ReadResource(ctx, state.ARN.ValueString(), priorTags)
 		diags.Append(tagDiags...)
 		state.Tags = tags
+		state.TagsAll = tagsAll
 	}
 
 	if diags.HasError() {
@@ -170,6 +170,13 @@ func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *t
 					),
 				},
 			},
+			"tags_all": schema.MapAttribute{
+				Description: "All tags of the AppStream application, including provider default tags.",
+				MarkdownDescription: "A map of all tags assigned to the AppStream application, " +
+					"including those inherited from the provider `default_tags`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"arn": schema.StringAttribute{
 				Description:         "ARN of the AppStream application.",
 				MarkdownDescription: "The Amazon Resource Name (ARN) of the AppStream application.",
@@ -136,7 +136,7 @@ func (r *resource) Update(ctx context.Context, req tfresource.UpdateRequest, res
 		return
 	}
 
-	newState, diags := r.readApplication(ctx, arn)
+	newState, diags := r.readApplication(ctx, arn, plan.Tags)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
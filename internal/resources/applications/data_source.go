@@ -0,0 +1,68 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/tags"
+)
+
+var (
+	_ datasource.DataSource              = &dataSource{}
+	_ datasource.DataSourceWithConfigure = &dataSource{}
+)
+
+func NewDataSource() datasource.DataSource {
+	return &dataSource{}
+}
+
+type dataSource struct {
+	appstreamClient metadata.AppStreamAPI
+	tags            *tags.TagManager
+	// region is the configured region. applications are listed per region
+	region string
+}
+
+func (ds *dataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_applications"
+}
+
+func (ds *dataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*metadata.Metadata)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Metadata, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if meta.Appstream == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *Metadata.Appstream, got: nil. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	if meta.Tagging == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *Metadata.Tagging, got: nil. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	ds.appstreamClient = meta.Appstream
+	ds.tags = tags.NewTagManager(meta.Tagging, meta.DefaultTags, meta.IgnoreTags)
+	ds.region = meta.Region
+}
@@ -0,0 +1,76 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var applicationObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":              types.StringType,
+		"arn":               types.StringType,
+		"display_name":      types.StringType,
+		"description":       types.StringType,
+		"launch_path":       types.StringType,
+		"enabled":           types.BoolType,
+		"platforms":         types.SetType{ElemType: types.StringType},
+		"instance_families": types.SetType{ElemType: types.StringType},
+		"app_block_arn":     types.StringType,
+		"created_time":      types.StringType,
+		"tags":              types.MapType{ElemType: types.StringType},
+	},
+}
+
+// flattenApplications returns the names, ARNs and key attributes of applications. Empty results are empty lists, not null.
+func flattenApplications(
+	ctx context.Context, applications []awstypes.Application, tagsByARN map[string]types.Map, diags *diag.Diagnostics,
+) (names types.List, arns types.List, applicationList types.List) {
+	nameValues := make([]string, 0, len(applications))
+	arnValues := make([]string, 0, len(applications))
+	models := make([]applicationModel, 0, len(applications))
+
+	for _, application := range applications {
+		arn := aws.ToString(application.Arn)
+
+		applicationTags, ok := tagsByARN[arn]
+		if !ok {
+			applicationTags = types.MapNull(types.StringType)
+		}
+
+		nameValues = append(nameValues, aws.ToString(application.Name))
+		arnValues = append(arnValues, arn)
+		models = append(models, applicationModel{
+			Name:             util.StringOrNull(application.Name),
+			ARN:              util.StringOrNull(application.Arn),
+			DisplayName:      util.StringOrNull(application.DisplayName),
+			Description:      util.StringOrNull(application.Description),
+			LaunchPath:       util.StringOrNull(application.LaunchPath),
+			Enabled:          util.BoolOrNull(application.Enabled),
+			Platforms:        util.SetEnumStringOrNull(ctx, application.Platforms, diags),
+			InstanceFamilies: util.SetStringOrNull(ctx, application.InstanceFamilies, diags),
+			AppBlockARN:      util.StringOrNull(application.AppBlockArn),
+			CreatedTime:      util.StringFromTime(application.CreatedTime),
+			Tags:             applicationTags,
+		})
+	}
+
+	names, d := types.ListValueFrom(ctx, types.StringType, nameValues)
+	diags.Append(d...)
+
+	arns, d = types.ListValueFrom(ctx, types.StringType, arnValues)
+	diags.Append(d...)
+
+	applicationList, d = types.ListValueFrom(ctx, applicationObjectType, models)
+	diags.Append(d...)
+
+	return names, arns, applicationList
+}
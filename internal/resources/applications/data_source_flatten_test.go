@@ -0,0 +1,74 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenApplications(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty_is_not_null", func(t *testing.T) {
+		var diags diag.Diagnostics
+		names, arns, applications := flattenApplications(ctx, nil, nil, &diags)
+		require.False(t, diags.HasError())
+		require.False(t, names.IsNull())
+		require.Empty(t, names.Elements())
+		require.False(t, arns.IsNull())
+		require.Empty(t, arns.Elements())
+		require.False(t, applications.IsNull())
+		require.Empty(t, applications.Elements())
+	})
+
+	t.Run("tags_are_attached_by_arn", func(t *testing.T) {
+		prodTags := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"env": types.StringValue("prod"),
+		})
+
+		var diags diag.Diagnostics
+		names, arns, applications := flattenApplications(ctx, []awstypes.Application{
+			{
+				Name:             aws.String("a"),
+				Arn:              aws.String("arn:a"),
+				LaunchPath:       aws.String(`C:\Program Files\App\app.exe`),
+				Enabled:          aws.Bool(true),
+				Platforms:        []awstypes.PlatformType{awstypes.PlatformTypeWindowsServer2022},
+				InstanceFamilies: []string{"GENERAL_PURPOSE"},
+				AppBlockArn:      aws.String("arn:app-block"),
+			},
+			{
+				Name: aws.String("b"),
+				Arn:  aws.String("arn:b"),
+			},
+		}, map[string]types.Map{"arn:a": prodTags}, &diags)
+		require.False(t, diags.HasError())
+
+		require.Equal(t, []attr.Value{types.StringValue("a"), types.StringValue("b")}, names.Elements())
+		require.Equal(t, []attr.Value{types.StringValue("arn:a"), types.StringValue("arn:b")}, arns.Elements())
+
+		var models []applicationModel
+		diags.Append(applications.ElementsAs(ctx, &models, false)...)
+		require.False(t, diags.HasError())
+		require.Len(t, models, 2)
+
+		require.True(t, models[0].Enabled.ValueBool())
+		require.Equal(t, "arn:app-block", models[0].AppBlockARN.ValueString())
+		require.Equal(t, []attr.Value{types.StringValue("WINDOWS_SERVER_2022")}, models[0].Platforms.Elements())
+		require.Equal(t, []attr.Value{types.StringValue("GENERAL_PURPOSE")}, models[0].InstanceFamilies.Elements())
+		require.True(t, models[0].Tags.Equal(prodTags))
+
+		require.True(t, models[1].Platforms.IsNull())
+		require.True(t, models[1].Enabled.IsNull())
+		require.True(t, models[1].Tags.IsNull())
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type dataSourceModel struct {
+	// ID is a synthetic identifier equal to the configured region.
+	ID types.String `tfsdk:"id"`
+	// NameRegex only selects applications whose name matches the regular expression (optional).
+	NameRegex types.String `tfsdk:"name_regex"`
+	// Tags only selects applications carrying all of these tags (optional).
+	Tags types.Map `tfsdk:"tags"`
+	// Names are the names of the matching applications (computed).
+	Names types.List `tfsdk:"names"`
+	// ARNs are the ARNs of the matching applications (computed).
+	ARNs types.List `tfsdk:"arns"`
+	// Applications are the key attributes of the matching applications (computed).
+	Applications types.List `tfsdk:"applications"`
+}
+
+type applicationModel struct {
+	Name             types.String `tfsdk:"name"`
+	ARN              types.String `tfsdk:"arn"`
+	DisplayName      types.String `tfsdk:"display_name"`
+	Description      types.String `tfsdk:"description"`
+	LaunchPath       types.String `tfsdk:"launch_path"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	Platforms        types.Set    `tfsdk:"platforms"`
+	InstanceFamilies types.Set    `tfsdk:"instance_families"`
+	AppBlockARN      types.String `tfsdk:"app_block_arn"`
+	CreatedTime      types.String `tfsdk:"created_time"`
+	Tags             types.Map    `tfsdk:"tags"`
+}
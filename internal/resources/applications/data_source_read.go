@@ -0,0 +1,107 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/tags"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+// applicationResourceType is the Resource Groups Tagging resource type of AppStream applications.
+const applicationResourceType = "appstream:application"
+
+func (ds *dataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config dataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	regex, err := util.RegexpOrNil(config.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Configuration",
+			fmt.Sprintf("Could not compile name_regex: %v", err),
+		)
+		return
+	}
+
+	applications, err := ds.listApplications(ctx, regex)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading AWS AppStream Applications",
+			fmt.Sprintf("Could not list applications: %v", err),
+		)
+		return
+	}
+
+	applications, tagsByARN, diags := tags.FilterByResourceType(
+		ctx, ds.tags, applicationResourceType, config.Tags, applications, func(a awstypes.Application) *string { return a.Arn },
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(ds.region)
+	config.Names, config.ARNs, config.Applications = flattenApplications(ctx, applications, tagsByARN, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listApplications pages through DescribeApplications and returns the applications matching regex, sorted by name.
+func (ds *dataSource) listApplications(ctx context.Context, regex *regexp.Regexp) ([]awstypes.Application, error) {
+	var out []awstypes.Application
+	var nextToken *string
+
+	for {
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeApplications.html
+		resp, err := ds.appstreamClient.DescribeApplications(ctx, &awsappstream.DescribeApplicationsInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, application := range resp.Applications {
+			if regex != nil && !regex.MatchString(aws.ToString(application.Name)) {
+				continue
+			}
+			out = append(out, application)
+		}
+
+		if resp.NextToken == nil || *resp.NextToken == "" {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return aws.ToString(out[i].Name) < aws.ToString(out[j].Name)
+	})
+
+	return out, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (ds *dataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List AWS AppStream Applications",
+		MarkdownDescription: "Lists the AppStream applications of the configured region. " +
+			"All filters are optional and combined, so only applications matching every configured filter are returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the AppStream applications.",
+				MarkdownDescription: "A synthetic identifier for the applications, equal to the configured region.",
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression to match application names.",
+				MarkdownDescription: "A regular expression used to match AppStream application names. " +
+					"Uses Go regular expression syntax.",
+				Optional: true,
+				Validators: []validator.String{
+					util.ValidRegex(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of the AppStream applications.",
+				MarkdownDescription: "Only return applications carrying all of these tags. " +
+					"Tags are resolved through the Resource Groups Tagging API.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"names": schema.ListAttribute{
+				Description:         "Names of the AppStream applications.",
+				MarkdownDescription: "The names of the matching applications, sorted by name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"arns": schema.ListAttribute{
+				Description:         "ARNs of the AppStream applications.",
+				MarkdownDescription: "The ARNs of the matching applications, in the same order as `names`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"applications": schema.ListNestedAttribute{
+				Description:         "AppStream applications.",
+				MarkdownDescription: "The key attributes of the matching applications, in the same order as `names`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description:         "Name of the application.",
+							MarkdownDescription: "The name of the application.",
+							Computed:            true,
+						},
+						"arn": schema.StringAttribute{
+							Description:         "ARN of the application.",
+							MarkdownDescription: "The ARN of the application.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							Description:         "Display name of the application.",
+							MarkdownDescription: "The application name displayed to end users, if set.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							Description:         "Description of the application.",
+							MarkdownDescription: "The application description, if set.",
+							Computed:            true,
+						},
+						"launch_path": schema.StringAttribute{
+							Description:         "Application launch path.",
+							MarkdownDescription: "The path to the application executable within the image.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description:         "Whether the application is enabled.",
+							MarkdownDescription: "Whether the application is enabled.",
+							Computed:            true,
+						},
+						"platforms": schema.SetAttribute{
+							Description:         "Supported platforms.",
+							MarkdownDescription: "The platforms on which the application can run.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"instance_families": schema.SetAttribute{
+							Description:         "Supported instance families.",
+							MarkdownDescription: "The instance families supported by the application.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"app_block_arn": schema.StringAttribute{
+							Description:         "App block ARN.",
+							MarkdownDescription: "The ARN of the app block associated with the application.",
+							Computed:            true,
+						},
+						"created_time": schema.StringAttribute{
+							Description:         "Time the application was created.",
+							MarkdownDescription: "The timestamp when the application was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"tags": schema.MapAttribute{
+							Description:         "Tags applied to the application.",
+							MarkdownDescription: "Tags assigned to the AppStream application.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
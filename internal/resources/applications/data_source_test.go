@@ -0,0 +1,44 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+// applications need app blocks with packaged S3 artifacts, so only the listing and filtering itself is covered here
+func testAccApplicationsDataSourceConfig() string {
+	return testhelpers.TestAccProviderBasicConfig() + `
+data "awsappstream_applications" "all" {}
+
+data "awsappstream_applications" "no_match" {
+  name_regex = "^tf-acc-applications-ds-missing-"
+
+  tags = {
+    TestName = "tf-acc-applications-ds-missing"
+  }
+}
+`
+}
+
+func TestAccApplicationsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.awsappstream_applications.all", "id"),
+					resource.TestCheckResourceAttrSet("data.awsappstream_applications.all", "names.#"),
+					resource.TestCheckResourceAttr("data.awsappstream_applications.no_match", "names.#", "0"),
+					resource.TestCheckResourceAttr("data.awsappstream_applications.no_match", "applications.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_app_block_builder_app_block
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildID(t *testing.T) {
+	require.Equal(t,
+		"builder1|arn:aws:appstream:eu-west-1:123456789012:app-block/test-app-block",
+		buildID("builder1", "arn:aws:appstream:eu-west-1:123456789012:app-block/test-app-block"),
+	)
+}
+
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		wantBuilder  string
+		wantAppBlock string
+		wantErr      bool
+	}{
+		{
+			name:         "valid_id",
+			id:           "builder1|arn:aws:appstream:eu-west-1:123456789012:app-block/test-app-block",
+			wantBuilder:  "builder1",
+			wantAppBlock: "arn:aws:appstream:eu-west-1:123456789012:app-block/test-app-block",
+			wantErr:      false,
+		},
+		{
+			name:    "missing_separator",
+			id:      "builder1-arn:aws:appstream:eu-west-1:123456789012:app-block/test-app-block",
+			wantErr: true,
+		},
+		{
+			name:    "empty_string",
+			id:      "",
+			wantErr: true,
+		},
+		{
+			name:    "empty_app_block_builder_name",
+			id:      "|arn:aws:appstream:eu-west-1:123456789012:app-block/test-app-block",
+			wantErr: true,
+		},
+		{
+			name:    "empty_app_block_arn",
+			id:      "builder1|",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder, appBlock, err := parseID(tt.id)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if builder != tt.wantBuilder || appBlock != tt.wantAppBlock {
+				t.Fatalf(
+					"parseID(%q) = (%q, %q), want (%q, %q)",
+					tt.id, builder, appBlock, tt.wantBuilder, tt.wantAppBlock,
+				)
+			}
+		})
+	}
+}
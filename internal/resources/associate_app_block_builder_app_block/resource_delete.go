@@ -0,0 +1,56 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_app_block_builder_app_block
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	var state model
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	addDiagnostics(state, &resp.Diagnostics, diagnosticDelete)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appBlockBuilderName := state.AppBlockBuilderName.ValueString()
+	appBlockARN := state.AppBlockARN.ValueString()
+
+	_, err := r.appstreamClient.DisassociateAppBlockBuilderAppBlock(ctx, &awsappstream.DisassociateAppBlockBuilderAppBlockInput{
+		AppBlockBuilderName: aws.String(appBlockBuilderName),
+		AppBlockArn:         aws.String(appBlockARN),
+	})
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		// if it's already gone, that's fine for delete.
+		if util.IsAppStreamNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting AWS AppStream App Block Builder App Block Association",
+			fmt.Sprintf("Could not disassociate app block %q from app block builder %q: %v", appBlockARN, appBlockBuilderName, err),
+		)
+		return
+	}
+}
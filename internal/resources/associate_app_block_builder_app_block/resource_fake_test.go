@@ -0,0 +1,81 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_app_block_builder_app_block_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/fake"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testFakeAssociateAppBlockBuilderAppBlockConfig(appBlockBuilderName, appBlockARN string) string {
+	return testhelpers.TestFakeProviderConfig() + fmt.Sprintf(`
+resource "awsappstream_associate_app_block_builder_app_block" "test" {
+  app_block_builder_name = %q
+  app_block_arn          = %q
+}
+`, appBlockBuilderName, appBlockARN)
+}
+
+func testFakeAppBlockBuilderAppBlockDisassociated(backend *fake.Backend, appBlockBuilderName string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		out, err := backend.DescribeAppBlockBuilderAppBlockAssociations(context.Background(),
+			&awsappstream.DescribeAppBlockBuilderAppBlockAssociationsInput{
+				AppBlockBuilderName: aws.String(appBlockBuilderName),
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if len(out.AppBlockBuilderAppBlockAssociations) > 0 {
+			return fmt.Errorf("app block builder %q still has associated app blocks", appBlockBuilderName)
+		}
+		return nil
+	}
+}
+
+func TestFakeAssociateAppBlockBuilderAppBlock_lifecycle(t *testing.T) {
+	backend := fake.New()
+	appBlockBuilderName := "tf-fake-app-block-builder"
+	backend.AddAppBlockBuilder(appBlockBuilderName)
+	appBlockARN := aws.ToString(backend.AddAppBlock("tf-fake-app-block").Arn)
+
+	resourceName := "awsappstream_associate_app_block_builder_app_block.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestFakePreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.FakeProtoV6ProviderFactories(backend),
+		CheckDestroy:             testFakeAppBlockBuilderAppBlockDisassociated(backend, appBlockBuilderName),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeAssociateAppBlockBuilderAppBlockConfig(appBlockBuilderName, appBlockARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", appBlockBuilderName+"|"+appBlockARN),
+					resource.TestCheckResourceAttr(resourceName, "app_block_builder_name", appBlockBuilderName),
+					resource.TestCheckResourceAttr(resourceName, "app_block_arn", appBlockARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testFakeAssociateAppBlockBuilderAppBlockConfig(appBlockBuilderName, appBlockARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testDisassociateAppBlockBuilderAppBlock(backend, resourceName),
+				),
+				// the removed association is dropped from state and planned again
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
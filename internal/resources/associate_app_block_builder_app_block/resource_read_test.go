@@ -0,0 +1,61 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_app_block_builder_app_block
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/fake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceRead_RemovesDisassociated(t *testing.T) {
+	tests := []struct {
+		name       string
+		associated bool
+	}{
+		{name: "associated", associated: true},
+		{name: "disassociated"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			backend := fake.New()
+			backend.AddAppBlockBuilder("builder")
+			appBlockARN := aws.ToString(backend.AddAppBlock("app-block").Arn)
+
+			if tt.associated {
+				_, err := backend.AssociateAppBlockBuilderAppBlock(ctx, &awsappstream.AssociateAppBlockBuilderAppBlockInput{
+					AppBlockBuilderName: aws.String("builder"),
+					AppBlockArn:         aws.String(appBlockARN),
+				})
+				require.NoError(t, err)
+			}
+
+			r := &resource{}
+			var configureResp tfresource.ConfigureResponse
+			r.Configure(ctx, tfresource.ConfigureRequest{ProviderData: backend.Metadata(nil)}, &configureResp)
+			require.False(t, configureResp.Diagnostics.HasError(), "%v", configureResp.Diagnostics)
+
+			var schemaResp tfresource.SchemaResponse
+			r.Schema(ctx, tfresource.SchemaRequest{}, &schemaResp)
+
+			state := fake.State(t, schemaResp.Schema, map[string]any{
+				"id":                     buildID("builder", appBlockARN),
+				"app_block_builder_name": "builder",
+				"app_block_arn":          appBlockARN,
+			})
+
+			resp := tfresource.ReadResponse{State: state}
+			r.Read(ctx, tfresource.ReadRequest{State: state}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			require.Equal(t, !tt.associated, resp.State.Raw.IsNull())
+		})
+	}
+}
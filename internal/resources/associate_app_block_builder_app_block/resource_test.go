@@ -0,0 +1,114 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_app_block_builder_app_block_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccAssociateAppBlockBuilderAppBlockConfig(appBlockBuilderName, appBlockName, subnetID, bucket string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_app_block_builder" "test" {
+  name          = %q
+  platform      = "WINDOWS_SERVER_2019"
+  instance_type = "stream.standard.small"
+
+  vpc_config = {
+    subnet_ids = [%q]
+  }
+}
+
+resource "awsappstream_app_block" "test" {
+  name           = %q
+  packaging_type = "APPSTREAM2"
+
+  source_s3_location = {
+    s3_bucket = %q
+  }
+}
+
+resource "awsappstream_associate_app_block_builder_app_block" "test" {
+  app_block_builder_name = awsappstream_app_block_builder.test.name
+  app_block_arn          = awsappstream_app_block.test.arn
+}
+`, appBlockBuilderName, subnetID, appBlockName, bucket)
+}
+
+// testDisassociateAppBlockBuilderAppBlock removes the association outside of terraform.
+func testDisassociateAppBlockBuilderAppBlock(client metadata.AppStreamAPI, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		_, err := client.DisassociateAppBlockBuilderAppBlock(context.Background(), &awsappstream.DisassociateAppBlockBuilderAppBlockInput{
+			AppBlockBuilderName: aws.String(rs.Primary.Attributes["app_block_builder_name"]),
+			AppBlockArn:         aws.String(rs.Primary.Attributes["app_block_arn"]),
+		})
+		return err
+	}
+}
+
+func TestAccAssociateAppBlockBuilderAppBlock_basic(t *testing.T) {
+	appBlockBuilderName := acctest.RandomWithPrefix("tf-acc-app-block-builder")
+	appBlockName := acctest.RandomWithPrefix("tf-acc-app-block")
+	subnetID := testhelpers.TestAccSubnetID(t)
+	bucket := testhelpers.TestAccAppBlockS3Bucket(t)
+
+	resourceName := "awsappstream_associate_app_block_builder_app_block.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociateAppBlockBuilderAppBlockConfig(appBlockBuilderName, appBlockName, subnetID, bucket),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "app_block_builder_name", appBlockBuilderName),
+					resource.TestCheckResourceAttrPair(resourceName, "app_block_arn", "awsappstream_app_block.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAssociateAppBlockBuilderAppBlock_disappears(t *testing.T) {
+	appBlockBuilderName := acctest.RandomWithPrefix("tf-acc-app-block-builder")
+	appBlockName := acctest.RandomWithPrefix("tf-acc-app-block")
+	subnetID := testhelpers.TestAccSubnetID(t)
+	bucket := testhelpers.TestAccAppBlockS3Bucket(t)
+
+	resourceName := "awsappstream_associate_app_block_builder_app_block.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociateAppBlockBuilderAppBlockConfig(appBlockBuilderName, appBlockName, subnetID, bucket),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testDisassociateAppBlockBuilderAppBlock(testhelpers.TestAccAppStreamClient(t), resourceName),
+				),
+				// the removed association is dropped from state and planned again
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
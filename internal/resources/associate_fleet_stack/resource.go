@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
@@ -24,7 +23,7 @@ func NewResource() tfresource.Resource {
 }
 
 type resource struct {
-	appstreamClient *awsappstream.Client
+	appstreamClient metadata.AppStreamAPI
 }
 
 func (r *resource) Metadata(_ context.Context, req tfresource.MetadataRequest, resp *tfresource.MetadataResponse) {
@@ -0,0 +1,35 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import "sort"
+
+// diffSoftwareNames returns the applications to disassociate and to associate to get from current to desired.
+func diffSoftwareNames(current, desired []string) (toDisassociate, toAssociate []string) {
+	currentSet := make(map[string]struct{}, len(current))
+	for _, name := range current {
+		currentSet[name] = struct{}{}
+	}
+
+	desiredSet := make(map[string]struct{}, len(desired))
+	for _, name := range desired {
+		desiredSet[name] = struct{}{}
+	}
+
+	for name := range currentSet {
+		if _, ok := desiredSet[name]; !ok {
+			toDisassociate = append(toDisassociate, name)
+		}
+	}
+
+	for name := range desiredSet {
+		if _, ok := currentSet[name]; !ok {
+			toAssociate = append(toAssociate, name)
+		}
+	}
+
+	sort.Strings(toDisassociate)
+	sort.Strings(toAssociate)
+	return toDisassociate, toAssociate
+}
@@ -0,0 +1,51 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffSoftwareNames(t *testing.T) {
+	tests := []struct {
+		name             string
+		current          []string
+		desired          []string
+		wantDisassociate []string
+		wantAssociate    []string
+	}{
+		{
+			name:          "create",
+			desired:       []string{"b", "a"},
+			wantAssociate: []string{"a", "b"},
+		},
+		{
+			name:             "delete",
+			current:          []string{"a", "b"},
+			wantDisassociate: []string{"a", "b"},
+		},
+		{
+			name:    "unchanged",
+			current: []string{"a", "b"},
+			desired: []string{"b", "a"},
+		},
+		{
+			name:             "swap",
+			current:          []string{"a", "b"},
+			desired:          []string{"b", "c"},
+			wantDisassociate: []string{"a"},
+			wantAssociate:    []string{"c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotDisassociate, gotAssociate := diffSoftwareNames(tt.current, tt.desired)
+			require.Equal(t, tt.wantDisassociate, gotDisassociate)
+			require.Equal(t, tt.wantAssociate, gotAssociate)
+		})
+	}
+}
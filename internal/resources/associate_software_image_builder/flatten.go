@@ -0,0 +1,106 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var deploymentErrorObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"error_code":    types.StringType,
+		"error_message": types.StringType,
+	},
+}
+
+var softwareAssociationObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"software_name":     types.StringType,
+		"status":            types.StringType,
+		"deployment_errors": types.ListType{ElemType: deploymentErrorObjectType},
+	},
+}
+
+// isUninstalling reports whether the application was disassociated and is, or failed to be, removed
+// from the image builder. those applications are reported by AWS but are no longer associated.
+func isUninstalling(status awstypes.SoftwareDeploymentStatus) bool {
+	switch status {
+	case awstypes.SoftwareDeploymentStatusStagedForUninstallation,
+		awstypes.SoftwareDeploymentStatusPendingUninstallation,
+		awstypes.SoftwareDeploymentStatusFailedToUninstall:
+		return true
+	default:
+		return false
+	}
+}
+
+// associatedSoftwareNames returns the sorted names of the applications that are associated with the image builder.
+func associatedSoftwareNames(associations []awstypes.SoftwareAssociations) []string {
+	names := make([]string, 0, len(associations))
+	for _, a := range associations {
+		if a.SoftwareName == nil || isUninstalling(a.Status) {
+			continue
+		}
+		names = append(names, aws.ToString(a.SoftwareName))
+	}
+	sort.Strings(names)
+	return names
+}
+
+func flattenSoftwareAssociations(
+	ctx context.Context, associations []awstypes.SoftwareAssociations, diags *diag.Diagnostics,
+) types.Set {
+
+	out := make([]softwareAssociationModel, 0, len(associations))
+	for _, a := range associations {
+		if a.SoftwareName == nil {
+			continue
+		}
+
+		status := types.StringNull()
+		if a.Status != "" {
+			status = types.StringValue(string(a.Status))
+		}
+
+		out = append(out, softwareAssociationModel{
+			SoftwareName:     types.StringValue(aws.ToString(a.SoftwareName)),
+			Status:           status,
+			DeploymentErrors: flattenDeploymentErrors(ctx, a.DeploymentError, diags),
+		})
+	}
+
+	setVal, d := types.SetValueFrom(ctx, softwareAssociationObjectType, out)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.SetNull(softwareAssociationObjectType)
+	}
+
+	return setVal
+}
+
+func flattenDeploymentErrors(ctx context.Context, awsErrors []awstypes.ErrorDetails, diags *diag.Diagnostics) types.List {
+	out := make([]deploymentErrorModel, 0, len(awsErrors))
+	for _, e := range awsErrors {
+		out = append(out, deploymentErrorModel{
+			ErrorCode:    util.StringOrNull(e.ErrorCode),
+			ErrorMessage: util.StringOrNull(e.ErrorMessage),
+		})
+	}
+
+	listVal, d := types.ListValueFrom(ctx, deploymentErrorObjectType, out)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ListNull(deploymentErrorObjectType)
+	}
+
+	return listVal
+}
@@ -0,0 +1,97 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func testSoftwareAssociations() []awstypes.SoftwareAssociations {
+	return []awstypes.SoftwareAssociations{
+		{
+			SoftwareName: aws.String("Microsoft_Visio_2021_LTSC_Professional_64Bit"),
+			Status:       awstypes.SoftwareDeploymentStatusInstalled,
+		},
+		{
+			SoftwareName: aws.String("Microsoft_Office_2021_LTSC_Professional_Plus_64Bit"),
+			Status:       awstypes.SoftwareDeploymentStatusFailedToInstall,
+			DeploymentError: []awstypes.ErrorDetails{
+				{ErrorCode: aws.String("INSTALL_FAILED"), ErrorMessage: aws.String("disk full")},
+			},
+		},
+		{
+			SoftwareName: aws.String("Microsoft_Project_2021_Professional_64Bit"),
+			Status:       awstypes.SoftwareDeploymentStatusPendingUninstallation,
+		},
+		{
+			Status: awstypes.SoftwareDeploymentStatusInstalled,
+		},
+	}
+}
+
+func TestAssociatedSoftwareNames(t *testing.T) {
+	require.Equal(t, []string{
+		"Microsoft_Office_2021_LTSC_Professional_Plus_64Bit",
+		"Microsoft_Visio_2021_LTSC_Professional_64Bit",
+	}, associatedSoftwareNames(testSoftwareAssociations()))
+
+	require.Empty(t, associatedSoftwareNames(nil))
+}
+
+func TestFlattenSoftwareAssociations(t *testing.T) {
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	got := flattenSoftwareAssociations(ctx, testSoftwareAssociations(), &diags)
+	require.False(t, diags.HasError())
+
+	var associations []softwareAssociationModel
+	diags.Append(got.ElementsAs(ctx, &associations, false)...)
+	require.False(t, diags.HasError())
+	require.Len(t, associations, 3)
+
+	byName := map[string]softwareAssociationModel{}
+	for _, a := range associations {
+		byName[a.SoftwareName.ValueString()] = a
+	}
+
+	office := byName["Microsoft_Office_2021_LTSC_Professional_Plus_64Bit"]
+	require.Equal(t, "FAILED_TO_INSTALL", office.Status.ValueString())
+
+	var errs []deploymentErrorModel
+	diags.Append(office.DeploymentErrors.ElementsAs(ctx, &errs, false)...)
+	require.False(t, diags.HasError())
+	require.Len(t, errs, 1)
+	require.Equal(t, "INSTALL_FAILED", errs[0].ErrorCode.ValueString())
+	require.Equal(t, "disk full", errs[0].ErrorMessage.ValueString())
+
+	project := byName["Microsoft_Project_2021_Professional_64Bit"]
+	require.Equal(t, "PENDING_UNINSTALLATION", project.Status.ValueString())
+	require.False(t, project.DeploymentErrors.IsNull())
+	require.Empty(t, project.DeploymentErrors.Elements())
+}
+
+func TestFormatDeploymentErrors(t *testing.T) {
+	got := formatDeploymentErrors([]awstypes.SoftwareAssociations{
+		{
+			SoftwareName: aws.String("b"),
+			Status:       awstypes.SoftwareDeploymentStatusFailedToUninstall,
+		},
+		{
+			SoftwareName: aws.String("a"),
+			Status:       awstypes.SoftwareDeploymentStatusFailedToInstall,
+			DeploymentError: []awstypes.ErrorDetails{
+				{ErrorCode: aws.String("E1"), ErrorMessage: aws.String("boom")},
+			},
+		},
+	})
+
+	require.Equal(t, "a (FAILED_TO_INSTALL): E1: boom; b (FAILED_TO_UNINSTALL): no errors reported by aws", got)
+}
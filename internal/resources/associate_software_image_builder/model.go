@@ -0,0 +1,35 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type model struct {
+	// ID is a synthetic identifier composed of "<image_builder_name>".
+	ID types.String `tfsdk:"id"`
+	// ImageBuilderName is the name of the image builder the software is associated with (required).
+	ImageBuilderName types.String `tfsdk:"image_builder_name"`
+	// SoftwareNames is the complete set of license included applications associated with the image builder (required).
+	SoftwareNames types.Set `tfsdk:"software_names"`
+	// Deploy starts a software deployment after every change and waits for it to finish (optional, computed).
+	Deploy types.Bool `tfsdk:"deploy"`
+	// SoftwareAssociations is the deployment status of every associated application as reported by AWS (computed).
+	SoftwareAssociations types.Set `tfsdk:"software_associations"`
+}
+
+type softwareAssociationModel struct {
+	// SoftwareName is the name of the license included application.
+	SoftwareName types.String `tfsdk:"software_name"`
+	// Status is the deployment status of the application.
+	Status types.String `tfsdk:"status"`
+	// DeploymentErrors are the errors of a failed deployment of the application.
+	DeploymentErrors types.List `tfsdk:"deployment_errors"`
+}
+
+type deploymentErrorModel struct {
+	// ErrorCode is the error code.
+	ErrorCode types.String `tfsdk:"error_code"`
+	// ErrorMessage is the error message.
+	ErrorMessage types.String `tfsdk:"error_message"`
+}
@@ -0,0 +1,62 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) associateSoftware(ctx context.Context, imageBuilderName string, softwareNames []string) error {
+	if len(softwareNames) == 0 {
+		return nil
+	}
+
+	// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_AssociateSoftwareToImageBuilder.html
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			_, err := r.appstreamClient.AssociateSoftwareToImageBuilder(ctx, &awsappstream.AssociateSoftwareToImageBuilderInput{
+				ImageBuilderName: aws.String(imageBuilderName),
+				SoftwareNames:    softwareNames,
+			})
+			return err
+		},
+		util.WithTimeout(associateRetryTimeout),
+		util.WithInitBackoff(associateRetryInitBackoff),
+		util.WithMaxBackoff(associateRetryMaxBackoff),
+		util.WithRetryOnFns(
+			util.IsConcurrentModificationException,
+			util.IsOperationNotPermittedException,
+		),
+	)
+}
+
+func (r *resource) disassociateSoftware(ctx context.Context, imageBuilderName string, softwareNames []string) error {
+	if len(softwareNames) == 0 {
+		return nil
+	}
+
+	// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DisassociateSoftwareFromImageBuilder.html
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			_, err := r.appstreamClient.DisassociateSoftwareFromImageBuilder(ctx, &awsappstream.DisassociateSoftwareFromImageBuilderInput{
+				ImageBuilderName: aws.String(imageBuilderName),
+				SoftwareNames:    softwareNames,
+			})
+			return err
+		},
+		util.WithTimeout(associateRetryTimeout),
+		util.WithInitBackoff(associateRetryInitBackoff),
+		util.WithMaxBackoff(associateRetryMaxBackoff),
+		util.WithRetryOnFns(
+			util.IsConcurrentModificationException,
+			util.IsOperationNotPermittedException,
+		),
+	)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	var plan model
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	if plan.ImageBuilderName.IsNull() || plan.ImageBuilderName.IsUnknown() ||
+		plan.SoftwareNames.IsNull() || plan.SoftwareNames.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Plan",
+			"Cannot associate software with image builder because image_builder_name and software_names must be known.",
+		)
+		return
+	}
+
+	name := plan.ImageBuilderName.ValueString()
+
+	var softwareNames []string
+	resp.Diagnostics.Append(plan.SoftwareNames.ElementsAs(ctx, &softwareNames, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sort.Strings(softwareNames)
+
+	if err := r.associateSoftware(ctx, name, softwareNames); err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating AWS AppStream Image Builder Software Association",
+			fmt.Sprintf("Could not associate software %v with image builder %q: %v", softwareNames, name, err),
+		)
+		return
+	}
+
+	if plan.Deploy.ValueBool() {
+		arn, err := r.imageBuilderARN(ctx, name)
+		if err == nil && arn == "" {
+			err = ErrImageBuilderNotFound
+		}
+		if err == nil {
+			err = r.deploySoftware(ctx, name, arn)
+		}
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			// keep the associated software in state. terraform taints the resource, so the next apply replaces it
+			resp.Diagnostics.AddError(
+				"Error Deploying AWS AppStream Image Builder Software",
+				fmt.Sprintf("Could not deploy software to image builder %q: %v", name, err),
+			)
+		}
+	}
+
+	newState, diags := r.readSoftwareAssociations(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"AWS AppStream Image Builder Software Association Not Found After Creation",
+			fmt.Sprintf("The software associated with image builder %q could not be read back.", name),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import (
+	"context"
+	"testing"
+
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/fake"
+	"github.com/stretchr/testify/require"
+)
+
+// associatingBackend is a backend that accepts software associations for any image builder.
+type associatingBackend struct {
+	*fake.Backend
+}
+
+func (b associatingBackend) AssociateSoftwareToImageBuilder(
+	context.Context, *awsappstream.AssociateSoftwareToImageBuilderInput, ...func(*awsappstream.Options),
+) (*awsappstream.AssociateSoftwareToImageBuilderOutput, error) {
+	return &awsappstream.AssociateSoftwareToImageBuilderOutput{}, nil
+}
+
+func TestResourceCreate_DeployWithoutImageBuilder(t *testing.T) {
+	ctx := context.Background()
+
+	meta := fake.New().Metadata(nil)
+	meta.Appstream = associatingBackend{meta.Appstream.(*fake.Backend)}
+
+	r := &resource{}
+	var configureResp tfresource.ConfigureResponse
+	r.Configure(ctx, tfresource.ConfigureRequest{ProviderData: meta}, &configureResp)
+	require.False(t, configureResp.Diagnostics.HasError(), "%v", configureResp.Diagnostics)
+
+	var schemaResp tfresource.SchemaResponse
+	r.Schema(ctx, tfresource.SchemaRequest{}, &schemaResp)
+
+	plan := fake.Plan(t, schemaResp.Schema, map[string]any{
+		"image_builder_name": "builder",
+		"software_names":     []string{"software"},
+		"deploy":             true,
+	})
+
+	resp := tfresource.CreateResponse{State: fake.State(t, schemaResp.Schema, nil)}
+	r.Create(ctx, tfresource.CreateRequest{Plan: plan}, &resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	require.Equal(t, "Error Deploying AWS AppStream Image Builder Software", resp.Diagnostics.Errors()[0].Summary())
+	require.Contains(t, resp.Diagnostics.Errors()[0].Detail(), ErrImageBuilderNotFound.Error())
+}
@@ -0,0 +1,74 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import (
+	"context"
+	"fmt"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	var state model
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	name := state.ImageBuilderName.ValueString()
+
+	var softwareNames []string
+	resp.Diagnostics.Append(state.SoftwareNames.ElementsAs(ctx, &softwareNames, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.disassociateSoftware(ctx, name, softwareNames); err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		// if the image builder is already gone, that's fine for delete.
+		if util.IsAppStreamNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting AWS AppStream Image Builder Software Association",
+			fmt.Sprintf("Could not disassociate software %v from image builder %q: %v", softwareNames, name, err),
+		)
+		return
+	}
+
+	if !state.Deploy.ValueBool() {
+		return
+	}
+
+	arn, err := r.imageBuilderARN(ctx, name)
+	if err == nil && arn != "" {
+		err = r.deploySoftware(ctx, name, arn)
+	}
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		if util.IsAppStreamNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deploying AWS AppStream Image Builder Software",
+			fmt.Sprintf("Could not uninstall software from image builder %q: %v", name, err),
+		)
+		return
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Read(ctx context.Context, req tfresource.ReadRequest, resp *tfresource.ReadResponse) {
+	var state model
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	newState, diags := r.readSoftwareAssociations(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *resource) readSoftwareAssociations(ctx context.Context, prior model) (*model, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name := prior.ImageBuilderName.ValueString()
+
+	arn, err := r.imageBuilderARN(ctx, name)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return nil, diags
+		}
+
+		diags.AddError(
+			"Error Reading AWS AppStream Image Builder Software Association",
+			fmt.Sprintf("Could not read image builder %q: %v", name, err),
+		)
+		return nil, diags
+	}
+
+	// the image builder is gone, so are its software associations
+	if arn == "" {
+		return nil, diags
+	}
+
+	associations, err := r.describeSoftwareAssociations(ctx, arn)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return nil, diags
+		}
+
+		if util.IsAppStreamNotFound(err) {
+			return nil, diags
+		}
+
+		diags.AddError(
+			"Error Reading AWS AppStream Image Builder Software Association",
+			fmt.Sprintf("Could not read software associated with image builder %q: %v", name, err),
+		)
+		return nil, diags
+	}
+
+	names := associatedSoftwareNames(associations)
+	if len(names) == 0 {
+		return nil, diags
+	}
+
+	deploy := prior.Deploy
+	if deploy.IsNull() || deploy.IsUnknown() {
+		deploy = types.BoolValue(false)
+	}
+
+	state := &model{
+		ID:                   types.StringValue(name),
+		ImageBuilderName:     types.StringValue(name),
+		SoftwareNames:        util.SetStringOrNull(ctx, names, &diags),
+		Deploy:               deploy,
+		SoftwareAssociations: flattenSoftwareAssociations(ctx, associations, &diags),
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return state, diags
+}
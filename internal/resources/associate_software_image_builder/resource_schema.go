@@ -0,0 +1,117 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the license included software associated with an AWS AppStream Image Builder",
+		MarkdownDescription: "Manages the license included applications, such as Microsoft Office, that are associated with an AppStream image builder. " +
+			"This resource is authoritative: applications that are not listed in `software_names` are disassociated from the image builder. " +
+			"Associated applications are installed by a software deployment, which this resource can start with `deploy`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the AppStream image builder software association.",
+				MarkdownDescription: "A synthetic identifier for the software association, equal to the image builder name. " +
+					"This value is managed by the provider and cannot be set manually.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_builder_name": schema.StringAttribute{
+				Description: "Name of the AppStream image builder.",
+				MarkdownDescription: "The name of the AppStream image builder to associate the software with. " +
+					"Changing this value forces the software association to be replaced.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"software_names": schema.SetAttribute{
+				Description: "License included applications associated with the image builder.",
+				MarkdownDescription: "The complete set of license included applications associated with the image builder, " +
+					"for example `Microsoft_Office_2021_LTSC_Professional_Plus_64Bit`.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+			"deploy": schema.BoolAttribute{
+				Description: "Whether to deploy the software to the image builder.",
+				MarkdownDescription: "Whether to start a software deployment to the image builder after the associated applications change " +
+					"and wait until every application is installed or uninstalled. " +
+					"Without a deployment, the applications are only staged. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"software_associations": schema.SetNestedAttribute{
+				Description: "Deployment status of the associated software.",
+				MarkdownDescription: "The deployment status of every license included application AWS reports for the image builder, " +
+					"including applications that are being uninstalled.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"software_name": schema.StringAttribute{
+							Description:         "Name of the application.",
+							MarkdownDescription: "The name of the license included application.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Deployment status of the application.",
+							MarkdownDescription: "The deployment status of the application. One of `STAGED_FOR_INSTALLATION`, " +
+								"`PENDING_INSTALLATION`, `INSTALLED`, `STAGED_FOR_UNINSTALLATION`, `PENDING_UNINSTALLATION`, " +
+								"`FAILED_TO_INSTALL` or `FAILED_TO_UNINSTALL`.",
+							Computed: true,
+						},
+						"deployment_errors": schema.ListNestedAttribute{
+							Description:         "Errors of a failed deployment.",
+							MarkdownDescription: "The errors reported for a failed deployment of the application.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"error_code": schema.StringAttribute{
+										Description:         "Error code.",
+										MarkdownDescription: "The error code.",
+										Computed:            true,
+									},
+									"error_message": schema.StringAttribute{
+										Description:         "Error message.",
+										MarkdownDescription: "The error message.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var (
+	ErrUnexpectedDeploymentStatus = errors.New("unexpected software deployment status")
+	ErrSoftwareDeploymentFailed   = errors.New("software deployment failed")
+	ErrImageBuilderNotFound       = errors.New("image builder not found")
+)
+
+// imageBuilderARN returns the ARN of the image builder, or an empty string if it does not exist.
+func (r *resource) imageBuilderARN(ctx context.Context, name string) (string, error) {
+	out, err := r.appstreamClient.DescribeImageBuilders(ctx, &awsappstream.DescribeImageBuildersInput{
+		Names: []string{name},
+	})
+	if err != nil {
+		if util.IsAppStreamNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	for _, imageBuilder := range out.ImageBuilders {
+		if aws.ToString(imageBuilder.Name) == name {
+			return aws.ToString(imageBuilder.Arn), nil
+		}
+	}
+
+	return "", nil
+}
+
+func (r *resource) describeSoftwareAssociations(ctx context.Context, imageBuilderARN string) ([]awstypes.SoftwareAssociations, error) {
+	var associations []awstypes.SoftwareAssociations
+	var nextToken *string
+
+	for {
+		out, err := r.appstreamClient.DescribeSoftwareAssociations(ctx, &awsappstream.DescribeSoftwareAssociationsInput{
+			AssociatedResource: aws.String(imageBuilderARN),
+			MaxResults:         aws.Int32(AppStreamMaxResults),
+			NextToken:          nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		associations = append(associations, out.SoftwareAssociations...)
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			return associations, nil
+		}
+		nextToken = out.NextToken
+	}
+}
+
+// deploySoftware starts a software deployment to the image builder and waits until every
+// application is installed or uninstalled.
+func (r *resource) deploySoftware(ctx context.Context, imageBuilderName, imageBuilderARN string) error {
+	// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_StartSoftwareDeploymentToImageBuilder.html
+	err := util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			_, err := r.appstreamClient.StartSoftwareDeploymentToImageBuilder(ctx, &awsappstream.StartSoftwareDeploymentToImageBuilderInput{
+				ImageBuilderName: aws.String(imageBuilderName),
+				// terraform converges, so applications that failed before are deployed again
+				RetryFailedDeployments: aws.Bool(true),
+			})
+			return err
+		},
+		util.WithTimeout(associateRetryTimeout),
+		util.WithInitBackoff(associateRetryInitBackoff),
+		util.WithMaxBackoff(associateRetryMaxBackoff),
+		util.WithRetryOnFns(
+			util.IsConcurrentModificationException,
+			util.IsOperationNotPermittedException,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	return r.waitSoftwareDeployment(ctx, imageBuilderARN)
+}
+
+// waitSoftwareDeployment waits until no application of the image builder is staged or pending.
+func (r *resource) waitSoftwareDeployment(ctx context.Context, imageBuilderARN string) error {
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			associations, err := r.describeSoftwareAssociations(ctx, imageBuilderARN)
+			if err != nil {
+				return err
+			}
+
+			var failed []awstypes.SoftwareAssociations
+			for _, a := range associations {
+				switch a.Status {
+				case awstypes.SoftwareDeploymentStatusInstalled:
+					// done
+				case awstypes.SoftwareDeploymentStatusFailedToInstall,
+					awstypes.SoftwareDeploymentStatusFailedToUninstall:
+					// terminal state. only a new deployment helps
+					failed = append(failed, a)
+				default:
+					// wait for staged and pending deployments to settle
+					return fmt.Errorf("%w: %s=%s", ErrUnexpectedDeploymentStatus, aws.ToString(a.SoftwareName), a.Status)
+				}
+			}
+
+			if len(failed) > 0 {
+				return fmt.Errorf("%w: %s", ErrSoftwareDeploymentFailed, formatDeploymentErrors(failed))
+			}
+
+			return nil
+		},
+		util.WithTimeout(deploymentWaitTimeout),
+		util.WithInitBackoff(deploymentWaitInitBackoff),
+		util.WithMaxBackoff(deploymentWaitMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeSoftwareAssociations.html
+		util.WithRetryOnFns(
+			func(err error) bool {
+				return errors.Is(err, ErrUnexpectedDeploymentStatus)
+			},
+		),
+	)
+}
+
+func formatDeploymentErrors(failed []awstypes.SoftwareAssociations) string {
+	msgs := make([]string, 0, len(failed))
+	for _, a := range failed {
+		if len(a.DeploymentError) == 0 {
+			msgs = append(msgs, fmt.Sprintf("%s (%s): no errors reported by aws", aws.ToString(a.SoftwareName), a.Status))
+			continue
+		}
+
+		for _, e := range a.DeploymentError {
+			msgs = append(msgs, fmt.Sprintf(
+				"%s (%s): %s: %s",
+				aws.ToString(a.SoftwareName), a.Status, aws.ToString(e.ErrorCode), aws.ToString(e.ErrorMessage),
+			))
+		}
+	}
+	sort.Strings(msgs)
+	return strings.Join(msgs, "; ")
+}
@@ -0,0 +1,70 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccAssociateSoftwareImageBuilderConfig(imageBuilderName string, softwareNames ...string) string {
+	quoted := make([]string, 0, len(softwareNames))
+	for _, name := range softwareNames {
+		quoted = append(quoted, fmt.Sprintf("%q", name))
+	}
+
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_associate_software_image_builder" "test" {
+  image_builder_name = %q
+  software_names     = [%s]
+}
+`, imageBuilderName, strings.Join(quoted, ", "))
+}
+
+func TestAccAssociateSoftwareImageBuilder_basic(t *testing.T) {
+	imageBuilderName := testhelpers.TestAccImageBuilderName(t)
+	resourceName := "awsappstream_associate_software_image_builder.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociateSoftwareImageBuilderConfig(
+					imageBuilderName,
+					"Microsoft_Office_2021_LTSC_Professional_Plus_64Bit",
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", imageBuilderName),
+					resource.TestCheckResourceAttr(resourceName, "deploy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "software_names.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "software_associations.*", map[string]string{
+						"software_name": "Microsoft_Office_2021_LTSC_Professional_Plus_64Bit",
+						"status":        "STAGED_FOR_INSTALLATION",
+					}),
+				),
+			},
+			{
+				Config: testAccAssociateSoftwareImageBuilderConfig(
+					imageBuilderName,
+					"Microsoft_Office_2021_LTSC_Professional_Plus_64Bit",
+					"Microsoft_Visio_2021_LTSC_Professional_64Bit",
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "software_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "software_names.*", "Microsoft_Visio_2021_LTSC_Professional_64Bit"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
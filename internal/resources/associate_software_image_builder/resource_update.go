@@ -0,0 +1,108 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import (
+	"context"
+	"fmt"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Update(ctx context.Context, req tfresource.UpdateRequest, resp *tfresource.UpdateResponse) {
+	var plan model
+	var state model
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	name := plan.ImageBuilderName.ValueString()
+
+	var current, desired []string
+	resp.Diagnostics.Append(state.SoftwareNames.ElementsAs(ctx, &current, false)...)
+	resp.Diagnostics.Append(plan.SoftwareNames.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toDisassociate, toAssociate := diffSoftwareNames(current, desired)
+
+	if err := r.disassociateSoftware(ctx, name, toDisassociate); err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating AWS AppStream Image Builder Software Association",
+			fmt.Sprintf("Could not disassociate software %v from image builder %q: %v", toDisassociate, name, err),
+		)
+		return
+	}
+
+	if err := r.associateSoftware(ctx, name, toAssociate); err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating AWS AppStream Image Builder Software Association",
+			fmt.Sprintf("Could not associate software %v with image builder %q: %v", toAssociate, name, err),
+		)
+		return
+	}
+
+	// deploy when the software changed or deploy was just turned on
+	changed := len(toDisassociate) > 0 || len(toAssociate) > 0
+	deployFailed := false
+	if plan.Deploy.ValueBool() && (changed || !state.Deploy.ValueBool()) {
+		arn, err := r.imageBuilderARN(ctx, name)
+		if err == nil && arn == "" {
+			err = ErrImageBuilderNotFound
+		}
+		if err == nil {
+			err = r.deploySoftware(ctx, name, arn)
+		}
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Deploying AWS AppStream Image Builder Software",
+				fmt.Sprintf("Could not deploy software to image builder %q: %v", name, err),
+			)
+			deployFailed = true
+		}
+	}
+
+	newState, diags := r.readSoftwareAssociations(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// record the failed deployment as not deployed, so that the next apply deploys again
+	if deployFailed {
+		newState.Deploy = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
@@ -0,0 +1,16 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package associate_software_image_builder
+
+import "time"
+
+const (
+	associateRetryTimeout     = 5 * time.Minute
+	associateRetryInitBackoff = 2 * time.Second
+	associateRetryMaxBackoff  = 30 * time.Second
+
+	deploymentWaitTimeout     = 90 * time.Minute
+	deploymentWaitInitBackoff = 30 * time.Second
+	deploymentWaitMaxBackoff  = 1 * time.Minute
+)
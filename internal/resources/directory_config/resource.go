@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
@@ -25,7 +24,7 @@ func NewResource() tfresource.Resource {
 }
 
 type resource struct {
-	appstreamClient *awsappstream.Client
+	appstreamClient metadata.AppStreamAPI
 	tags            *tags.TagManager
 }
 
@@ -64,7 +63,7 @@ func (r *resource) Configure(_ context.Context, req tfresource.ConfigureRequest,
 	}
 
 	r.appstreamClient = meta.Appstream
-	r.tags = tags.NewTagManager(meta.Tagging, meta.DefaultTags)
+	r.tags = tags.NewTagManager(meta.Tagging, meta.DefaultTags, meta.IgnoreTags)
 }
 
 func (r *resource) ImportState(ctx context.Context, req tfresource.ImportStateRequest, resp *tfresource.ImportStateResponse) {
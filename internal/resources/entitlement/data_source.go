@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
 )
@@ -22,7 +21,7 @@ func NewDataSource() datasource.DataSource {
 }
 
 type dataSource struct {
-	appstreamClient *awsappstream.Client
+	appstreamClient metadata.AppStreamAPI
 }
 
 func (ds *dataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -0,0 +1,81 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package entitlements
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var attributeObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":  types.StringType,
+		"value": types.StringType,
+	},
+}
+
+var entitlementObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":               types.StringType,
+		"description":        types.StringType,
+		"app_visibility":     types.StringType,
+		"attributes":         types.SetType{ElemType: attributeObjectType},
+		"created_time":       types.StringType,
+		"last_modified_time": types.StringType,
+	},
+}
+
+// flattenEntitlements returns the names and key attributes of entitlements. Empty results are empty lists, not null.
+func flattenEntitlements(
+	ctx context.Context, entitlements []awstypes.Entitlement, diags *diag.Diagnostics,
+) (names types.List, entitlementList types.List) {
+	nameValues := make([]string, 0, len(entitlements))
+	models := make([]entitlementModel, 0, len(entitlements))
+
+	for _, entitlement := range entitlements {
+		nameValues = append(nameValues, aws.ToString(entitlement.Name))
+		models = append(models, entitlementModel{
+			Name:             util.StringOrNull(entitlement.Name),
+			Description:      util.StringOrNull(entitlement.Description),
+			AppVisibility:    types.StringValue(string(entitlement.AppVisibility)),
+			Attributes:       flattenAttributes(ctx, entitlement.Attributes, diags),
+			CreatedTime:      util.StringFromTime(entitlement.CreatedTime),
+			LastModifiedTime: util.StringFromTime(entitlement.LastModifiedTime),
+		})
+	}
+
+	names, d := types.ListValueFrom(ctx, types.StringType, nameValues)
+	diags.Append(d...)
+
+	entitlementList, d = types.ListValueFrom(ctx, entitlementObjectType, models)
+	diags.Append(d...)
+
+	return names, entitlementList
+}
+
+func flattenAttributes(
+	ctx context.Context, awsEntitlementAttributes []awstypes.EntitlementAttribute, diags *diag.Diagnostics,
+) types.Set {
+	attrs := make([]attributeModel, 0, len(awsEntitlementAttributes))
+	for _, a := range awsEntitlementAttributes {
+		attrs = append(attrs, attributeModel{
+			Name:  types.StringValue(aws.ToString(a.Name)),
+			Value: types.StringValue(aws.ToString(a.Value)),
+		})
+	}
+
+	setVal, d := types.SetValueFrom(ctx, attributeObjectType, attrs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.SetNull(attributeObjectType)
+	}
+
+	return setVal
+}
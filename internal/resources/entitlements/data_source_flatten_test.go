@@ -0,0 +1,62 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package entitlements
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenEntitlements(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty_is_not_null", func(t *testing.T) {
+		var diags diag.Diagnostics
+		names, entitlements := flattenEntitlements(ctx, nil, &diags)
+		require.False(t, diags.HasError())
+		require.False(t, names.IsNull())
+		require.Empty(t, names.Elements())
+		require.False(t, entitlements.IsNull())
+		require.Empty(t, entitlements.Elements())
+	})
+
+	t.Run("key_attributes", func(t *testing.T) {
+		var diags diag.Diagnostics
+		names, entitlements := flattenEntitlements(ctx, []awstypes.Entitlement{
+			{
+				Name:          aws.String("finance"),
+				StackName:     aws.String("stack"),
+				AppVisibility: awstypes.AppVisibilityAssociated,
+				Attributes: []awstypes.EntitlementAttribute{
+					{Name: aws.String("department"), Value: aws.String("finance")},
+				},
+			},
+		}, &diags)
+		require.False(t, diags.HasError())
+
+		require.Equal(t, []attr.Value{types.StringValue("finance")}, names.Elements())
+
+		var models []entitlementModel
+		diags.Append(entitlements.ElementsAs(ctx, &models, false)...)
+		require.False(t, diags.HasError())
+		require.Len(t, models, 1)
+
+		require.Equal(t, "ASSOCIATED", models[0].AppVisibility.ValueString())
+		require.True(t, models[0].Description.IsNull())
+
+		var attrs []attributeModel
+		diags.Append(models[0].Attributes.ElementsAs(ctx, &attrs, false)...)
+		require.False(t, diags.HasError())
+		require.Equal(t, []attributeModel{
+			{Name: types.StringValue("department"), Value: types.StringValue("finance")},
+		}, attrs)
+	})
+}
@@ -0,0 +1,33 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package entitlements
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type dataSourceModel struct {
+	// ID is a synthetic identifier equal to the stack name.
+	ID types.String `tfsdk:"id"`
+	// StackName is the name of the stack whose entitlements are listed (required).
+	StackName types.String `tfsdk:"stack_name"`
+	// NameRegex only selects entitlements whose name matches the regular expression (optional).
+	NameRegex types.String `tfsdk:"name_regex"`
+	// Names are the names of the matching entitlements (computed).
+	Names types.List `tfsdk:"names"`
+	// Entitlements are the key attributes of the matching entitlements (computed).
+	Entitlements types.List `tfsdk:"entitlements"`
+}
+
+type entitlementModel struct {
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	AppVisibility    types.String `tfsdk:"app_visibility"`
+	Attributes       types.Set    `tfsdk:"attributes"`
+	CreatedTime      types.String `tfsdk:"created_time"`
+	LastModifiedTime types.String `tfsdk:"last_modified_time"`
+}
+
+type attributeModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
@@ -0,0 +1,116 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package entitlements
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (ds *dataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config dataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	if config.StackName.IsNull() || config.StackName.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Configuration",
+			"Cannot read entitlements because stack_name must be set and known.",
+		)
+		return
+	}
+
+	regex, err := util.RegexpOrNil(config.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Configuration",
+			fmt.Sprintf("Could not compile name_regex: %v", err),
+		)
+		return
+	}
+
+	stackName := config.StackName.ValueString()
+
+	entitlements, err := ds.listEntitlements(ctx, stackName, regex)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		if util.IsAppStreamNotFound(err) {
+			resp.Diagnostics.AddError(
+				"AWS AppStream Stack Not Found",
+				fmt.Sprintf("No stack named %q was found.", stackName),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading AWS AppStream Entitlements",
+			fmt.Sprintf("Could not list entitlements in stack %q: %v", stackName, err),
+		)
+		return
+	}
+
+	config.ID = types.StringValue(stackName)
+	config.Names, config.Entitlements = flattenEntitlements(ctx, entitlements, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listEntitlements pages through DescribeEntitlements and returns the entitlements matching regex, sorted by name.
+func (ds *dataSource) listEntitlements(
+	ctx context.Context, stackName string, regex *regexp.Regexp,
+) ([]awstypes.Entitlement, error) {
+	var out []awstypes.Entitlement
+	var nextToken *string
+
+	for {
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeEntitlements.html
+		resp, err := ds.appstreamClient.DescribeEntitlements(ctx, &awsappstream.DescribeEntitlementsInput{
+			StackName: aws.String(stackName),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entitlement := range resp.Entitlements {
+			if regex != nil && !regex.MatchString(aws.ToString(entitlement.Name)) {
+				continue
+			}
+			out = append(out, entitlement)
+		}
+
+		if resp.NextToken == nil || *resp.NextToken == "" {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return aws.ToString(out[i].Name) < aws.ToString(out[j].Name)
+	})
+
+	return out, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package entitlements
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (ds *dataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List AWS AppStream Entitlements",
+		MarkdownDescription: "Lists the AppStream entitlements of a stack. " +
+			"Entitlements have no ARN and cannot be tagged, so entitlements can only be filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the AppStream entitlements.",
+				MarkdownDescription: "A synthetic identifier for the entitlements, equal to the stack name.",
+				Computed:            true,
+			},
+			"stack_name": schema.StringAttribute{
+				Description:         "Name of the AppStream Stack.",
+				MarkdownDescription: "The name of the AppStream stack whose entitlements are listed.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression to match entitlement names.",
+				MarkdownDescription: "A regular expression used to match AppStream entitlement names. " +
+					"Uses Go regular expression syntax.",
+				Optional: true,
+				Validators: []validator.String{
+					util.ValidRegex(),
+				},
+			},
+			"names": schema.ListAttribute{
+				Description:         "Names of the AppStream entitlements.",
+				MarkdownDescription: "The names of the matching entitlements, sorted by name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"entitlements": schema.ListNestedAttribute{
+				Description:         "AppStream entitlements.",
+				MarkdownDescription: "The key attributes of the matching entitlements, in the same order as `names`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description:         "Name of the entitlement.",
+							MarkdownDescription: "The name of the entitlement.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							Description:         "Description of the entitlement.",
+							MarkdownDescription: "The entitlement description, if set.",
+							Computed:            true,
+						},
+						"app_visibility": schema.StringAttribute{
+							Description: "Visibility of applications for this entitlement.",
+							MarkdownDescription: "Controls which applications are visible to users who match the entitlement attributes. " +
+								"Valid values are `ALL` or `ASSOCIATED`.",
+							Computed: true,
+						},
+						"attributes": schema.SetNestedAttribute{
+							Description:         "Entitlement attribute used to match federated user sessions.",
+							MarkdownDescription: "The attribute rule used to match federated user attributes (AWS IAM SAML PrincipalTag).",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description:         "Attribute name.",
+										MarkdownDescription: "The AWS IAM SAML PrincipalTag attribute name.",
+										Computed:            true,
+									},
+									"value": schema.StringAttribute{
+										Description:         "Attribute value.",
+										MarkdownDescription: "The value that the attribute must match.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"created_time": schema.StringAttribute{
+							Description:         "Time the entitlement was created.",
+							MarkdownDescription: "The timestamp when the entitlement was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"last_modified_time": schema.StringAttribute{
+							Description:         "Time the entitlement was last modified.",
+							MarkdownDescription: "The timestamp when the entitlement was last modified, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
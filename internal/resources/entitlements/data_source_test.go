@@ -0,0 +1,67 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package entitlements_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccEntitlementsDataSourceConfig(name, stackName string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_stack" "test" {
+  name = %[2]q
+}
+
+resource "awsappstream_entitlement" "test" {
+  stack_name     = awsappstream_stack.test.name
+  name           = %[1]q
+  app_visibility = "ALL"
+
+  attributes = [
+    {
+      name  = "roles"
+      value = "test"
+    }
+  ]
+}
+
+data "awsappstream_entitlements" "test" {
+  stack_name = awsappstream_entitlement.test.stack_name
+}
+
+data "awsappstream_entitlements" "no_match" {
+  stack_name = awsappstream_entitlement.test.stack_name
+  name_regex = %[3]q
+}
+`, name, stackName, "^"+regexp.QuoteMeta(name)+"-missing$")
+}
+
+func TestAccEntitlementsDataSource_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-entitlements-ds")
+	stackName := acctest.RandomWithPrefix("tf-acc-entitlements-stack-ds")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntitlementsDataSourceConfig(name, stackName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.awsappstream_entitlements.test", "id", stackName),
+					resource.TestCheckResourceAttr("data.awsappstream_entitlements.test", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.awsappstream_entitlements.test", "names.0", name),
+					resource.TestCheckResourceAttr("data.awsappstream_entitlements.test", "entitlements.0.app_visibility", "ALL"),
+					resource.TestCheckResourceAttr("data.awsappstream_entitlements.test", "entitlements.0.attributes.#", "1"),
+					resource.TestCheckResourceAttr("data.awsappstream_entitlements.no_match", "entitlements.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleet_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccStartFleetActionConfig(name string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+action "awsappstream_start_fleet" "test" {
+  config {
+    name = %q
+  }
+}
+
+resource "terraform_data" "test" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.awsappstream_start_fleet.test]
+    }
+  }
+}
+`, name)
+}
+
+// the streaming fleet is expected to be running already, so starting it is a no-op
+func TestAccStartFleetAction_basic(t *testing.T) {
+	_, fleetName := testhelpers.TestAccStreamingStackFleet(t)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartFleetActionConfig(fleetName),
+			},
+		},
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleet
+
+import (
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// requiresStoppedFleet reports whether the update changes attributes that aws only
+// accepts while the fleet is stopped.
+// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_UpdateFleet.html
+func requiresStoppedFleet(plan, state model) bool {
+	changed := func(planValue, stateValue attr.Value) bool {
+		// unknown values are computed by aws and never sent
+		return !planValue.IsUnknown() && !planValue.Equal(stateValue)
+	}
+
+	if changed(plan.Description, state.Description) ||
+		changed(plan.InstanceType, state.InstanceType) ||
+		changed(plan.VPCConfig, state.VPCConfig) ||
+		changed(plan.MaxUserDurationInSeconds, state.MaxUserDurationInSeconds) ||
+		changed(plan.EnableDefaultInternetAccess, state.EnableDefaultInternetAccess) ||
+		changed(plan.DomainJoinInfo, state.DomainJoinInfo) ||
+		changed(plan.IAMRoleARN, state.IAMRoleARN) ||
+		changed(plan.StreamView, state.StreamView) ||
+		changed(plan.Platform, state.Platform) ||
+		changed(plan.MaxSessionsPerInstance, state.MaxSessionsPerInstance) ||
+		changed(plan.RootVolumeConfig, state.RootVolumeConfig) {
+		return true
+	}
+
+	if plan.FleetType.ValueString() == string(awstypes.FleetTypeElastic) {
+		// elastic fleets cannot swap their image while running
+		return changed(plan.ImageName, state.ImageName) ||
+			changed(plan.ImageARN, state.ImageARN)
+	}
+
+	// always-on and on-demand fleets cannot change session settings while running
+	return changed(plan.MaxConcurrentSessions, state.MaxConcurrentSessions) ||
+		changed(plan.SessionScriptS3Location, state.SessionScriptS3Location) ||
+		changed(plan.USBDeviceFilterStrings, state.USBDeviceFilterStrings)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleet
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testFleetModel(fleetType string) model {
+	return model{
+		Name:                           types.StringValue("fleet"),
+		ImageName:                      types.StringValue("image"),
+		ImageARN:                       types.StringNull(),
+		InstanceType:                   types.StringValue("stream.standard.small"),
+		FleetType:                      types.StringValue(fleetType),
+		ComputeCapacity:                types.ObjectNull(computeCapacityObjectType.AttrTypes),
+		VPCConfig:                      types.ObjectNull(vpcConfigObjectType.AttrTypes),
+		MaxUserDurationInSeconds:       types.Int32Value(57600),
+		DisconnectTimeoutInSeconds:     types.Int32Value(900),
+		IdleDisconnectTimeoutInSeconds: types.Int32Value(0),
+		Description:                    types.StringNull(),
+		DisplayName:                    types.StringNull(),
+		EnableDefaultInternetAccess:    types.BoolValue(false),
+		DomainJoinInfo:                 types.ObjectNull(domainJoinInfoObjectType.AttrTypes),
+		IAMRoleARN:                     types.StringNull(),
+		StreamView:                     types.StringNull(),
+		Platform:                       types.StringNull(),
+		MaxConcurrentSessions:          types.Int32Null(),
+		MaxSessionsPerInstance:         types.Int32Null(),
+		USBDeviceFilterStrings:         types.SetNull(types.StringType),
+		SessionScriptS3Location:        types.ObjectNull(sessionScriptS3LocationObjectType.AttrTypes),
+		RootVolumeConfig:               types.ObjectNull(rootVolumeConfigObjectType.AttrTypes),
+		DesiredState:                   types.StringNull(),
+		Tags:                           types.MapNull(types.StringType),
+	}
+}
+
+func TestRequiresStoppedFleet(t *testing.T) {
+	tests := []struct {
+		name      string
+		fleetType string
+		mutate    func(plan *model)
+		want      bool
+	}{
+		{
+			name:      "no_changes",
+			fleetType: "ON_DEMAND",
+			mutate:    func(*model) {},
+			want:      false,
+		},
+		{
+			name:      "display_name_allowed_while_running",
+			fleetType: "ON_DEMAND",
+			mutate: func(plan *model) {
+				plan.DisplayName = types.StringValue("display")
+			},
+			want: false,
+		},
+		{
+			name:      "image_allowed_while_running",
+			fleetType: "ALWAYS_ON",
+			mutate: func(plan *model) {
+				plan.ImageName = types.StringValue("other-image")
+				plan.ImageARN = types.StringUnknown()
+			},
+			want: false,
+		},
+		{
+			name:      "compute_capacity_allowed_while_running",
+			fleetType: "ON_DEMAND",
+			mutate: func(plan *model) {
+				plan.ComputeCapacity = types.ObjectValueMust(
+					computeCapacityObjectType.AttrTypes,
+					map[string]attr.Value{
+						"desired_instances": types.Int32Value(2),
+						"desired_sessions":  types.Int32Null(),
+					},
+				)
+			},
+			want: false,
+		},
+		{
+			name:      "tags_and_desired_state_allowed_while_running",
+			fleetType: "ON_DEMAND",
+			mutate: func(plan *model) {
+				plan.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")})
+				plan.DesiredState = types.StringValue("RUNNING")
+			},
+			want: false,
+		},
+		{
+			name:      "instance_type_requires_stop",
+			fleetType: "ON_DEMAND",
+			mutate: func(plan *model) {
+				plan.InstanceType = types.StringValue("stream.standard.large")
+			},
+			want: true,
+		},
+		{
+			name:      "vpc_config_requires_stop",
+			fleetType: "ALWAYS_ON",
+			mutate: func(plan *model) {
+				plan.VPCConfig = types.ObjectValueMust(
+					vpcConfigObjectType.AttrTypes,
+					map[string]attr.Value{
+						"subnet_ids":         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("subnet-1")}),
+						"security_group_ids": types.SetNull(types.StringType),
+					},
+				)
+			},
+			want: true,
+		},
+		{
+			name:      "domain_join_info_requires_stop",
+			fleetType: "ON_DEMAND",
+			mutate: func(plan *model) {
+				plan.DomainJoinInfo = types.ObjectValueMust(
+					domainJoinInfoObjectType.AttrTypes,
+					map[string]attr.Value{
+						"directory_name":                         types.StringValue("corp.example.com"),
+						"organizational_unit_distinguished_name": types.StringNull(),
+					},
+				)
+			},
+			want: true,
+		},
+		{
+			name:      "usb_filters_require_stop_for_non_elastic",
+			fleetType: "ON_DEMAND",
+			mutate: func(plan *model) {
+				plan.USBDeviceFilterStrings = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("filter")})
+			},
+			want: true,
+		},
+		{
+			name:      "usb_filters_allowed_while_running_for_elastic",
+			fleetType: "ELASTIC",
+			mutate: func(plan *model) {
+				plan.USBDeviceFilterStrings = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("filter")})
+			},
+			want: false,
+		},
+		{
+			name:      "image_requires_stop_for_elastic",
+			fleetType: "ELASTIC",
+			mutate: func(plan *model) {
+				plan.ImageName = types.StringValue("other-image")
+			},
+			want: true,
+		},
+		{
+			name:      "unknown_values_are_ignored",
+			fleetType: "ON_DEMAND",
+			mutate: func(plan *model) {
+				plan.InstanceType = types.StringUnknown()
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := testFleetModel(tt.fleetType)
+			plan := testFleetModel(tt.fleetType)
+			tt.mutate(&plan)
+
+			require.Equal(t, tt.want, requiresStoppedFleet(plan, state))
+		})
+	}
+}
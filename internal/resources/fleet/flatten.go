@@ -197,3 +197,34 @@ func flattenFleetErrors(ctx context.Context, awsFleetErrors []awstypes.FleetErro
 
 	return setVal
 }
+
+func flattenDesiredState(prior types.String, awsState awstypes.FleetState) types.String {
+	// user never set it
+	if prior.IsNull() {
+		return types.StringNull()
+	}
+
+	// terraform does not yet know
+	if prior.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	// transitional states count towards the state they settle in
+	switch awsState {
+	case awstypes.FleetStateRunning, awstypes.FleetStateStarting:
+		return types.StringValue(string(awstypes.FleetStateRunning))
+	case awstypes.FleetStateStopped, awstypes.FleetStateStopping:
+		return types.StringValue(string(awstypes.FleetStateStopped))
+	default:
+		return prior
+	}
+}
+
+func flattenForceDestroy(prior types.Bool) types.Bool {
+	// provider-only setting. aws knows nothing about it
+	if prior.IsNull() || prior.IsUnknown() {
+		return types.BoolValue(false)
+	}
+
+	return prior
+}
@@ -305,3 +305,99 @@ func TestFlattenErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenDesiredState(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior types.String
+		in    awstypes.FleetState
+		want  types.String
+	}{
+		{
+			name:  "prior_null",
+			prior: types.StringNull(),
+			in:    awstypes.FleetStateRunning,
+			want:  types.StringNull(),
+		},
+		{
+			name:  "prior_unknown",
+			prior: types.StringUnknown(),
+			in:    awstypes.FleetStateRunning,
+			want:  types.StringUnknown(),
+		},
+		{
+			name:  "running",
+			prior: types.StringValue("RUNNING"),
+			in:    awstypes.FleetStateRunning,
+			want:  types.StringValue("RUNNING"),
+		},
+		{
+			name:  "starting_counts_as_running",
+			prior: types.StringValue("RUNNING"),
+			in:    awstypes.FleetStateStarting,
+			want:  types.StringValue("RUNNING"),
+		},
+		{
+			name:  "stopping_counts_as_stopped",
+			prior: types.StringValue("RUNNING"),
+			in:    awstypes.FleetStateStopping,
+			want:  types.StringValue("STOPPED"),
+		},
+		{
+			name:  "drift_detected",
+			prior: types.StringValue("STOPPED"),
+			in:    awstypes.FleetStateRunning,
+			want:  types.StringValue("RUNNING"),
+		},
+		{
+			name:  "unknown_aws_state_keeps_prior",
+			prior: types.StringValue("STOPPED"),
+			in:    "",
+			want:  types.StringValue("STOPPED"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := flattenDesiredState(tt.prior, tt.in)
+
+			if !got.Equal(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlattenForceDestroy(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior types.Bool
+		want  types.Bool
+	}{
+		{
+			name:  "prior_null_after_import",
+			prior: types.BoolNull(),
+			want:  types.BoolValue(false),
+		},
+		{
+			name:  "prior_unknown",
+			prior: types.BoolUnknown(),
+			want:  types.BoolValue(false),
+		},
+		{
+			name:  "prior_true",
+			prior: types.BoolValue(true),
+			want:  types.BoolValue(true),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := flattenForceDestroy(tt.prior)
+
+			if !got.Equal(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
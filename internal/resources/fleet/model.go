@@ -56,8 +56,14 @@ type model struct {
 	SessionScriptS3Location types.Object `tfsdk:"session_script_s3_location"`
 	// RootVolumeConfig specifies the root volume configuration for the fleet (optional).
 	RootVolumeConfig types.Object `tfsdk:"root_volume_config"`
+	// DesiredState is the running state the fleet should converge to: RUNNING or STOPPED (optional).
+	DesiredState types.String `tfsdk:"desired_state"`
+	// ForceDestroy disassociates all stacks from the fleet before deletion (optional, computed).
+	ForceDestroy types.Bool `tfsdk:"force_destroy"`
 	// Tags is a map of tags to assign to the fleet (optional).
 	Tags types.Map `tfsdk:"tags"`
+	// TagsAll is the map of all tags assigned to the fleet, including provider default tags (computed).
+	TagsAll types.Map `tfsdk:"tags_all"`
 	// ARN is the ARN of the AppStream fleet (computed).
 	ARN types.String `tfsdk:"arn"`
 	// CreatedTime is the timestamp when the fleet was created (computed).
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,6 +20,7 @@ var (
 	_ tfresource.ResourceWithConfigure      = &resource{}
 	_ tfresource.ResourceWithValidateConfig = &resource{}
 	_ tfresource.ResourceWithImportState    = &resource{}
+	_ tfresource.ResourceWithModifyPlan     = &resource{}
 )
 
 func NewResource() tfresource.Resource {
@@ -28,7 +28,7 @@ func NewResource() tfresource.Resource {
 }
 
 type resource struct {
-	appstreamClient *awsappstream.Client
+	appstreamClient metadata.AppStreamAPI
 	tags            *tags.TagManager
 }
 
@@ -248,7 +248,7 @@ func (r *resource) Configure(_ context.Context, req tfresource.ConfigureRequest,
 	}
 
 	r.appstreamClient = meta.Appstream
-	r.tags = tags.NewTagManager(meta.Tagging, meta.DefaultTags)
+	r.tags = tags.NewTagManager(meta.Tagging, meta.DefaultTags, meta.IgnoreTags)
 }
 
 func (r *resource) ImportState(ctx context.Context, req tfresource.ImportStateRequest, resp *tfresource.ImportStateResponse) {
@@ -263,3 +263,12 @@ func (r *resource) ImportState(ctx context.Context, req tfresource.ImportStateRe
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
+
+func (r *resource) ModifyPlan(ctx context.Context, req tfresource.ModifyPlanRequest, resp *tfresource.ModifyPlanResponse) {
+	// provider not configured yet
+	if r.tags == nil {
+		return
+	}
+
+	r.tags.ModifyPlan(ctx, req, resp)
+}
@@ -143,9 +143,26 @@ func (r *resource) Create(ctx context.Context, req tfresource.CreateRequest, res
 		}
 	}
 
+	if !plan.DesiredState.IsNull() && !plan.DesiredState.IsUnknown() {
+		desiredState := plan.DesiredState.ValueString()
+
+		err = ensureFleetState(ctx, r.appstreamClient, name, awstypes.FleetState(desiredState), nil)
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Changing AWS AppStream Fleet State",
+				fmt.Sprintf("Could not change state of fleet %q to %s: %v", name, desiredState, err),
+			)
+			// keep the fleet in state so terraform taints it and deletes it on the next apply
+		}
+	}
+
 	newState, diags := r.readFleet(ctx, plan)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if diags.HasError() {
 		return
 	}
 
@@ -5,10 +5,12 @@ package fleet
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
 )
@@ -35,16 +37,24 @@ func (r *resource) Delete(ctx context.Context, req tfresource.DeleteRequest, res
 
 	name := state.Name.ValueString()
 
-	_, err := r.appstreamClient.DeleteFleet(ctx, &awsappstream.DeleteFleetInput{
-		Name: aws.String(name),
-	})
-	if err != nil {
-		if util.IsContextCanceled(err) {
+	if state.ForceDestroy.ValueBool() {
+		err := r.disassociateStacks(ctx, name)
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Deleting AWS AppStream Fleet",
+				fmt.Sprintf("Could not disassociate stacks from fleet %q: %v", name, err),
+			)
 			return
 		}
+	}
 
-		// if it's already gone, that's fine for delete.
-		if util.IsAppStreamNotFound(err) {
+	err := r.deleteFleet(ctx, name)
+	if err != nil {
+		if util.IsContextCanceled(err) {
 			return
 		}
 
@@ -55,3 +65,147 @@ func (r *resource) Delete(ctx context.Context, req tfresource.DeleteRequest, res
 		return
 	}
 }
+
+// disassociateStacks removes every stack association of the fleet, including
+// associations not managed by terraform, and waits until none are left.
+func (r *resource) disassociateStacks(ctx context.Context, name string) error {
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			stackNames, err := r.listAssociatedStacks(ctx, name)
+			if err != nil {
+				if util.IsAppStreamNotFound(err) {
+					// already deleted
+					return nil
+				}
+				return err
+			}
+
+			if len(stackNames) == 0 {
+				return nil
+			}
+
+			for _, stackName := range stackNames {
+				_, err = r.appstreamClient.DisassociateFleet(ctx, &awsappstream.DisassociateFleetInput{
+					FleetName: aws.String(name),
+					StackName: aws.String(stackName),
+				})
+				if err != nil && !util.IsAppStreamNotFound(err) {
+					return err
+				}
+			}
+
+			// re-check until the disassociations have settled
+			return fmt.Errorf("%w: stacks=%v", ErrFleetStillAssociated, stackNames)
+		},
+		util.WithTimeout(disassociateRetryTimeout),
+		util.WithInitBackoff(disassociateRetryInitBackoff),
+		util.WithMaxBackoff(disassociateRetryMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_ListAssociatedStacks.html
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DisassociateFleet.html
+		util.WithRetryOnFns(
+			func(err error) bool {
+				return errors.Is(err, ErrFleetStillAssociated)
+			},
+			util.IsConcurrentModificationException,
+			util.IsOperationNotPermittedException,
+		),
+	)
+}
+
+func (r *resource) listAssociatedStacks(ctx context.Context, name string) ([]string, error) {
+	var stackNames []string
+	var nextToken *string
+
+	for {
+		out, err := r.appstreamClient.ListAssociatedStacks(ctx, &awsappstream.ListAssociatedStacksInput{
+			FleetName: aws.String(name),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		stackNames = append(stackNames, out.Names...)
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	return stackNames, nil
+}
+
+func (r *resource) deleteFleet(ctx context.Context, name string) error {
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			out, err := r.appstreamClient.DescribeFleets(ctx, &awsappstream.DescribeFleetsInput{
+				Names: []string{name},
+			})
+			if err != nil {
+				if util.IsAppStreamNotFound(err) {
+					// already deleted
+					return nil
+				}
+				return err
+			}
+
+			if len(out.Fleets) == 0 {
+				return nil
+			}
+
+			state := out.Fleets[0].State
+
+			switch state {
+			case awstypes.FleetStateRunning:
+				// stoppable state
+				_, err = r.appstreamClient.StopFleet(ctx, &awsappstream.StopFleetInput{
+					Name: aws.String(name),
+				})
+				if err != nil {
+					if util.IsAppStreamNotFound(err) {
+						// already deleted
+						return nil
+					}
+					return err
+				}
+				// retry as we just stopped the fleet
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedFleetState, state)
+
+			case awstypes.FleetStateStopped:
+				// deletable state
+				_, err = r.appstreamClient.DeleteFleet(ctx, &awsappstream.DeleteFleetInput{
+					Name: aws.String(name),
+				})
+				if err != nil {
+					if util.IsAppStreamNotFound(err) {
+						// already deleted
+						return nil
+					}
+					return err
+				}
+				// wait until the fleet is gone
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedFleetState, state)
+
+			default:
+				// wait for starting or stopping to settle
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedFleetState, state)
+			}
+		},
+		util.WithTimeout(fleetWaitTimeout),
+		util.WithInitBackoff(fleetWaitInitBackoff),
+		util.WithMaxBackoff(fleetWaitMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeFleets.html
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_StopFleet.html
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DeleteFleet.html
+		util.WithRetryOnFns(
+			func(err error) bool {
+				return errors.Is(err, ErrUnexpectedFleetState)
+			},
+			util.IsConcurrentModificationException,
+			util.IsOperationNotPermittedException,
+		),
+	)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/fake"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceDelete_AssociatedStacks(t *testing.T) {
+	withoutFleetWait(t)
+
+	// more stacks than fit on one page of the fake backend
+	stackNames := []string{"stack-a", "stack-b", "stack-c"}
+
+	tests := []struct {
+		name         string
+		forceDestroy bool
+		wantError    string
+	}{
+		{
+			name:      "in_use",
+			wantError: "Error Deleting AWS AppStream Fleet",
+		},
+		{
+			name:         "force_destroy",
+			forceDestroy: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			backend := fake.New()
+			createRunningTestFleet(t, backend, "fleet")
+
+			for _, stackName := range stackNames {
+				_, err := backend.CreateStack(ctx, &awsappstream.CreateStackInput{Name: aws.String(stackName)})
+				require.NoError(t, err)
+
+				_, err = backend.AssociateFleet(ctx, &awsappstream.AssociateFleetInput{
+					FleetName: aws.String("fleet"),
+					StackName: aws.String(stackName),
+				})
+				require.NoError(t, err)
+			}
+
+			r := newTestResource(t, backend.Metadata(nil))
+
+			var schemaResp tfresource.SchemaResponse
+			r.Schema(ctx, tfresource.SchemaRequest{}, &schemaResp)
+
+			attributes := testFleetAttributes("fleet", "stream.standard.small")
+			attributes["force_destroy"] = tt.forceDestroy
+			state := fake.State(t, schemaResp.Schema, attributes)
+
+			var resp tfresource.DeleteResponse
+			r.Delete(ctx, tfresource.DeleteRequest{State: state}, &resp)
+
+			_, err := backend.DescribeFleets(ctx, &awsappstream.DescribeFleetsInput{Names: []string{"fleet"}})
+
+			if tt.wantError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				require.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+				require.NoError(t, err)
+				return
+			}
+
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			require.True(t, util.IsResourceNotFoundException(err))
+
+			for _, stackName := range stackNames {
+				out, err := backend.ListAssociatedFleets(ctx, &awsappstream.ListAssociatedFleetsInput{StackName: aws.String(stackName)})
+				require.NoError(t, err)
+				require.Empty(t, out.Names)
+			}
+		})
+	}
+}
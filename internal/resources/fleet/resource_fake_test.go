@@ -0,0 +1,88 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleet_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/fake"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func testFakeFleetConfig(name, description, environment string) string {
+	return testhelpers.TestFakeProviderConfig() + fmt.Sprintf(`
+resource "awsappstream_fleet" "test" {
+  name          = %q
+  description   = %q
+  fleet_type    = "ON_DEMAND"
+  instance_type = "stream.standard.small"
+  image_name    = "image"
+
+  compute_capacity = {
+    desired_instances = 1
+  }
+
+  tags = {
+    Environment = %q
+  }
+}
+`, name, description, environment)
+}
+
+func testFakeFleetDestroyed(backend *fake.Backend, name string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		_, err := backend.DescribeFleets(context.Background(), &awsappstream.DescribeFleetsInput{
+			Names: []string{name},
+		})
+		if !util.IsAppStreamNotFound(err) {
+			return fmt.Errorf("fleet %q still exists: %v", name, err)
+		}
+		return nil
+	}
+}
+
+func TestFakeFleet_lifecycle(t *testing.T) {
+	backend := fake.New()
+	name := "tf-fake-fleet"
+	resourceName := "awsappstream_fleet.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestFakePreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.FakeProtoV6ProviderFactories(backend),
+		CheckDestroy:             testFakeFleetDestroyed(backend, name),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeFleetConfig(name, "created", "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "arn", "arn:aws:appstream:us-east-1:123456789012:fleet/"+name),
+					resource.TestCheckResourceAttr(resourceName, "image_arn", "arn:aws:appstream:us-east-1::image/image"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created"),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.desired_instances", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "test"),
+				),
+			},
+			{
+				Config: testFakeFleetConfig(name, "updated", "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "prod"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -110,7 +110,10 @@ func (r *resource) readFleet(ctx context.Context, prior model) (*model, diag.Dia
 		USBDeviceFilterStrings:         util.SetStringOrNull(ctx, fleet.UsbDeviceFilterStrings, &diags),
 		SessionScriptS3Location:        flattenSessionScriptS3Location(ctx, fleet.SessionScriptS3Location, &diags),
 		RootVolumeConfig:               flattenRootVolumeConfig(ctx, fleet.RootVolumeConfig, &diags),
+		DesiredState:                   flattenDesiredState(prior.DesiredState, fleet.State),
+		ForceDestroy:                   flattenForceDestroy(prior.ForceDestroy),
 		Tags:                           types.MapNull(types.StringType),
+		TagsAll:                        types.MapNull(types.StringType),
 		ARN:                            util.StringOrNull(fleet.Arn),
 		CreatedTime:                    util.StringFromTime(fleet.CreatedTime),
 		State:                          types.StringValue(string(fleet.State)),
@@ -118,9 +121,10 @@ func (r *resource) readFleet(ctx context.Context, prior model) (*model, diag.Dia
 	}
 
 	if !state.ARN.IsNull() {
-		tags, tagDiags := r.tags.Read(ctx, state.ARN.ValueString())
+		tags, tagsAll, tagDiags := r.tags.ReadResource(ctx, state.ARN.ValueString(), prior.Tags)
 		diags.Append(tagDiags...)
 		state.Tags = tags
+		state.TagsAll = tagsAll
 	}
 
 	if diags.HasError() {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -25,7 +26,9 @@ func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *t
 		Description: "Manage an AWS AppStream Fleet",
 		MarkdownDescription: "Manages an AppStream fleet. " +
 			"A fleet defines the compute capacity, networking configuration, and runtime behavior " +
-			"for streaming instances that host AppStream user sessions.",
+			"for streaming instances that host AppStream user sessions. " +
+			"Running fleets are stopped before deletion and before updates that AWS only accepts on stopped fleets. " +
+			"After such an update the previous running state is restored.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Identifier of the AppStream fleet.",
@@ -326,6 +329,25 @@ func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *t
 					},
 				},
 			},
+			"desired_state": schema.StringAttribute{
+				Description: "Desired running state of the fleet.",
+				MarkdownDescription: "The running state the fleet should converge to. Valid values are `RUNNING` or `STOPPED`. " +
+					"When set, the provider starts or stops the fleet during create and update and waits until " +
+					"the fleet reaches this state. If not set, the running state of the fleet is not managed.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("RUNNING", "STOPPED"),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "Whether to disassociate all stacks before deleting the fleet.",
+				MarkdownDescription: "Whether to disassociate all stacks from the fleet before it is deleted, " +
+					"including associations that are not managed by Terraform. AWS refuses to delete associated fleets. " +
+					"Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"tags": schema.MapAttribute{
 				Description:         "Tags applied to the AppStream fleet.",
 				MarkdownDescription: "A map of tags assigned to the AppStream fleet.",
@@ -349,6 +371,13 @@ func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *t
 					),
 				},
 			},
+			"tags_all": schema.MapAttribute{
+				Description: "All tags of the AppStream fleet, including provider default tags.",
+				MarkdownDescription: "A map of all tags assigned to the AppStream fleet, " +
+					"including those inherited from the provider `default_tags`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"arn": schema.StringAttribute{
 				Description:         "ARN of the AppStream fleet.",
 				MarkdownDescription: "The Amazon Resource Name (ARN) of the AppStream fleet.",
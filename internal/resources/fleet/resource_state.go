@@ -0,0 +1,145 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var (
+	ErrUnexpectedFleetState = errors.New("unexpected fleet state")
+	ErrFleetStartFailed     = errors.New("fleet failed to start")
+	ErrFleetStillAssociated = errors.New("fleet still associated with stacks")
+)
+
+func (r *resource) describeFleetState(ctx context.Context, name string) (awstypes.FleetState, error) {
+	out, err := r.appstreamClient.DescribeFleets(ctx, &awsappstream.DescribeFleetsInput{
+		Names: []string{name},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(out.Fleets) == 0 {
+		return "", fmt.Errorf("fleet %q not found", name)
+	}
+
+	return out.Fleets[0].State, nil
+}
+
+// restoreRunningFleet starts a fleet that was stopped for an update which did not complete,
+// so that a failed update does not leave a previously running fleet stopped.
+func (r *resource) restoreRunningFleet(ctx context.Context, name string, diags *diag.Diagnostics) {
+	err := ensureFleetState(ctx, r.appstreamClient, name, awstypes.FleetStateRunning, nil)
+	if err == nil || util.IsContextCanceled(err) {
+		return
+	}
+
+	diags.AddError(
+		"Error Restoring AWS AppStream Fleet State",
+		fmt.Sprintf("Could not start fleet %q again after the failed update: %v", name, err),
+	)
+}
+
+// ensureFleetState starts or stops the fleet and waits until it reaches the target state.
+// progress is called with a human-readable message whenever the observed state changes and may be nil.
+func ensureFleetState(
+	ctx context.Context, client metadata.AppStreamAPI, name string, target awstypes.FleetState, progress func(string),
+) error {
+	started := false
+	var observed awstypes.FleetState
+
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			out, err := client.DescribeFleets(ctx, &awsappstream.DescribeFleetsInput{
+				Names: []string{name},
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(out.Fleets) == 0 {
+				return fmt.Errorf("fleet %q not found", name)
+			}
+
+			fleet := out.Fleets[0]
+			state := fleet.State
+
+			if progress != nil && state != observed && state != target {
+				progress(fmt.Sprintf("fleet %q is %s, waiting for %s", name, state, target))
+			}
+			observed = state
+
+			if state == target {
+				return nil
+			}
+
+			switch state {
+			case awstypes.FleetStateStopped:
+				// aws moves the fleet back to stopped if instances cannot be provisioned
+				if started && len(fleet.FleetErrors) > 0 {
+					return fmt.Errorf("%w: %s", ErrFleetStartFailed, formatFleetErrors(fleet.FleetErrors))
+				}
+
+				// startable state
+				_, err = client.StartFleet(ctx, &awsappstream.StartFleetInput{
+					Name: aws.String(name),
+				})
+				if err != nil {
+					return err
+				}
+				started = true
+				// retry as we just started the fleet
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedFleetState, state)
+
+			case awstypes.FleetStateRunning:
+				// stoppable state
+				_, err = client.StopFleet(ctx, &awsappstream.StopFleetInput{
+					Name: aws.String(name),
+				})
+				if err != nil {
+					return err
+				}
+				// retry as we just stopped the fleet
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedFleetState, state)
+
+			default:
+				// wait for starting or stopping to settle
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedFleetState, state)
+			}
+		},
+		util.WithTimeout(fleetWaitTimeout),
+		util.WithInitBackoff(fleetWaitInitBackoff),
+		util.WithMaxBackoff(fleetWaitMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeFleets.html
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_StartFleet.html
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_StopFleet.html
+		util.WithRetryOnFns(
+			func(err error) bool {
+				return errors.Is(err, ErrUnexpectedFleetState)
+			},
+			util.IsConcurrentModificationException,
+			util.IsOperationNotPermittedException,
+		),
+	)
+}
+
+func formatFleetErrors(fleetErrors []awstypes.FleetError) string {
+	msgs := make([]string, 0, len(fleetErrors))
+	for _, e := range fleetErrors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", e.ErrorCode, aws.ToString(e.ErrorMessage)))
+	}
+	return strings.Join(msgs, "; ")
+}
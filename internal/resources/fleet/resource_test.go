@@ -216,3 +216,83 @@ func TestAccFleet_idleTimeout(t *testing.T) {
 		},
 	})
 }
+
+func testAccFleetDesiredStateConfig(name, desiredState string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_fleet" "test" {
+  name          = %q
+  fleet_type    = "ON_DEMAND"
+  instance_type = "stream.standard.small"
+  image_name    = "Amazon-AppStream2-Sample-Image-06-17-2024"
+
+  desired_state = %q
+
+  compute_capacity = {
+    desired_instances = 0
+  }
+}
+`, name, desiredState)
+}
+
+func TestAccFleet_desiredState(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-fleet-state")
+	resourceName := "awsappstream_fleet.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetDesiredStateConfig(name, "RUNNING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+				),
+			},
+			{
+				Config: testAccFleetDesiredStateConfig(name, "STOPPED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFleetRunningInstanceTypeConfig(name, instanceType string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_fleet" "test" {
+  name          = %q
+  fleet_type    = "ON_DEMAND"
+  instance_type = %q
+  image_name    = "Amazon-AppStream2-Sample-Image-06-17-2024"
+
+  desired_state = "RUNNING"
+
+  compute_capacity = {
+    desired_instances = 0
+  }
+}
+`, name, instanceType)
+}
+
+func TestAccFleet_updateInstanceTypeWhileRunning(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-fleet-running")
+	resourceName := "awsappstream_fleet.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{Config: testAccFleetRunningInstanceTypeConfig(name, "stream.standard.small")},
+			{
+				Config: testAccFleetRunningInstanceTypeConfig(name, "stream.standard.medium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.medium"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+				),
+			},
+		},
+	})
+}
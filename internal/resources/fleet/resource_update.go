@@ -195,6 +195,50 @@ func (r *resource) Update(ctx context.Context, req tfresource.UpdateRequest, res
 		return
 	}
 
+	// aws rejects most attribute changes on running fleets. stop the fleet first and
+	// restore its previous running state once the update is done, also if the update fails.
+	restoreRunning := false
+	defer func() {
+		if restoreRunning {
+			r.restoreRunningFleet(ctx, name, &resp.Diagnostics)
+		}
+	}()
+	if requiresStoppedFleet(plan, state) {
+		current, err := r.describeFleetState(ctx, name)
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			if util.IsAppStreamNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Reading AWS AppStream Fleet State",
+				fmt.Sprintf("Could not read state of fleet %q: %v", name, err),
+			)
+			return
+		}
+
+		if current == awstypes.FleetStateRunning || current == awstypes.FleetStateStarting {
+			err = ensureFleetState(ctx, r.appstreamClient, name, awstypes.FleetStateStopped, nil)
+			if err != nil {
+				if util.IsContextCanceled(err) {
+					return
+				}
+
+				resp.Diagnostics.AddError(
+					"Error Stopping AWS AppStream Fleet",
+					fmt.Sprintf("Could not stop fleet %q before update: %v", name, err),
+				)
+				return
+			}
+			restoreRunning = true
+		}
+	}
+
 	out, err := r.appstreamClient.UpdateFleet(ctx, input)
 	if err != nil {
 		if util.IsContextCanceled(err) {
@@ -202,6 +246,7 @@ func (r *resource) Update(ctx context.Context, req tfresource.UpdateRequest, res
 		}
 
 		if util.IsAppStreamNotFound(err) {
+			restoreRunning = false
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -221,6 +266,31 @@ func (r *resource) Update(ctx context.Context, req tfresource.UpdateRequest, res
 		}
 	}
 
+	desiredState := ""
+	switch {
+	case !plan.DesiredState.IsNull() && !plan.DesiredState.IsUnknown():
+		desiredState = plan.DesiredState.ValueString()
+	case restoreRunning:
+		desiredState = string(awstypes.FleetStateRunning)
+	}
+	// the desired state takes over from the deferred restore
+	restoreRunning = false
+
+	if desiredState != "" {
+		err = ensureFleetState(ctx, r.appstreamClient, name, awstypes.FleetState(desiredState), nil)
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Changing AWS AppStream Fleet State",
+				fmt.Sprintf("Could not change state of fleet %q to %s: %v", name, desiredState, err),
+			)
+			return
+		}
+	}
+
 	newState, diags := r.readFleet(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,137 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/fake"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+	"github.com/stretchr/testify/require"
+)
+
+// failingUpdateFleet is a backend whose UpdateFleet calls always fail.
+type failingUpdateFleet struct {
+	*fake.Backend
+}
+
+func (f failingUpdateFleet) UpdateFleet(
+	context.Context, *awsappstream.UpdateFleetInput, ...func(*awsappstream.Options),
+) (*awsappstream.UpdateFleetOutput, error) {
+	return nil, errors.New("update failed")
+}
+
+func withoutFleetWait(t *testing.T) {
+	t.Helper()
+
+	initBackoff, maxBackoff := fleetWaitInitBackoff, fleetWaitMaxBackoff
+	fleetWaitInitBackoff, fleetWaitMaxBackoff = time.Millisecond, time.Millisecond
+	disassociateInitBackoff, disassociateMaxBackoff := disassociateRetryInitBackoff, disassociateRetryMaxBackoff
+	disassociateRetryInitBackoff, disassociateRetryMaxBackoff = time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		fleetWaitInitBackoff, fleetWaitMaxBackoff = initBackoff, maxBackoff
+		disassociateRetryInitBackoff, disassociateRetryMaxBackoff = disassociateInitBackoff, disassociateMaxBackoff
+	})
+}
+
+func newTestResource(t *testing.T, meta *metadata.Metadata) *resource {
+	t.Helper()
+
+	r := &resource{}
+	var resp tfresource.ConfigureResponse
+	r.Configure(context.Background(), tfresource.ConfigureRequest{ProviderData: meta}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	return r
+}
+
+func createRunningTestFleet(t *testing.T, backend *fake.Backend, name string) {
+	t.Helper()
+
+	ctx := context.Background()
+	_, err := backend.CreateFleet(ctx, &awsappstream.CreateFleetInput{
+		Name:            aws.String(name),
+		InstanceType:    aws.String("stream.standard.small"),
+		ImageName:       aws.String("image"),
+		ComputeCapacity: &awstypes.ComputeCapacity{DesiredInstances: aws.Int32(1)},
+	})
+	require.NoError(t, err)
+
+	_, err = backend.StartFleet(ctx, &awsappstream.StartFleetInput{Name: aws.String(name)})
+	require.NoError(t, err)
+}
+
+func testFleetAttributes(name, instanceType string) map[string]any {
+	return map[string]any{
+		"id":                                 name,
+		"name":                               name,
+		"instance_type":                      instanceType,
+		"image_name":                         "image",
+		"fleet_type":                         string(awstypes.FleetTypeOnDemand),
+		"compute_capacity.desired_instances": int32(1),
+	}
+}
+
+func TestResourceUpdate_RestoresRunningFleet(t *testing.T) {
+	withoutFleetWait(t)
+
+	tests := []struct {
+		name      string
+		appstream func(*fake.Backend) metadata.AppStreamAPI
+		wantError string
+		wantType  string
+	}{
+		{
+			name:      "after_update",
+			appstream: func(b *fake.Backend) metadata.AppStreamAPI { return b },
+			wantType:  "stream.standard.medium",
+		},
+		{
+			name:      "after_failed_update",
+			appstream: func(b *fake.Backend) metadata.AppStreamAPI { return failingUpdateFleet{b} },
+			wantError: "Error Updating AWS AppStream Fleet",
+			wantType:  "stream.standard.small",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			backend := fake.New()
+			createRunningTestFleet(t, backend, "fleet")
+
+			meta := backend.Metadata(nil)
+			meta.Appstream = tt.appstream(backend)
+			r := newTestResource(t, meta)
+
+			var schemaResp tfresource.SchemaResponse
+			r.Schema(ctx, tfresource.SchemaRequest{}, &schemaResp)
+
+			state := fake.State(t, schemaResp.Schema, testFleetAttributes("fleet", "stream.standard.small"))
+			plan := fake.Plan(t, schemaResp.Schema, testFleetAttributes("fleet", "stream.standard.medium"))
+
+			resp := tfresource.UpdateResponse{State: state}
+			r.Update(ctx, tfresource.UpdateRequest{Plan: plan, State: state}, &resp)
+
+			if tt.wantError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				require.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+			} else {
+				require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			}
+
+			out, err := backend.DescribeFleets(ctx, &awsappstream.DescribeFleetsInput{Names: []string{"fleet"}})
+			require.NoError(t, err)
+			require.Equal(t, awstypes.FleetStateRunning, out.Fleets[0].State)
+			require.Equal(t, tt.wantType, aws.ToString(out.Fleets[0].InstanceType))
+		})
+	}
+}
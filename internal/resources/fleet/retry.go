@@ -10,3 +10,14 @@ const (
 	createRetryInitBackoff = 10 * time.Second
 	createRetryMaxBackoff  = 2 * time.Minute
 )
+
+// variables so that tests against the fake backend can wait for state changes without delay
+var (
+	disassociateRetryTimeout     = 5 * time.Minute
+	disassociateRetryInitBackoff = 2 * time.Second
+	disassociateRetryMaxBackoff  = 1 * time.Minute
+
+	fleetWaitTimeout     = 45 * time.Minute
+	fleetWaitInitBackoff = 30 * time.Second
+	fleetWaitMaxBackoff  = 1 * time.Minute
+)
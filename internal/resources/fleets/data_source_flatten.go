@@ -0,0 +1,78 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleets
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var fleetObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":          types.StringType,
+		"arn":           types.StringType,
+		"display_name":  types.StringType,
+		"description":   types.StringType,
+		"fleet_type":    types.StringType,
+		"instance_type": types.StringType,
+		"platform":      types.StringType,
+		"state":         types.StringType,
+		"image_name":    types.StringType,
+		"image_arn":     types.StringType,
+		"created_time":  types.StringType,
+		"tags":          types.MapType{ElemType: types.StringType},
+	},
+}
+
+// flattenFleets returns the names, ARNs and key attributes of fleets. Empty results are empty lists, not null.
+func flattenFleets(
+	ctx context.Context, fleets []awstypes.Fleet, tagsByARN map[string]types.Map, diags *diag.Diagnostics,
+) (names types.List, arns types.List, fleetList types.List) {
+	nameValues := make([]string, 0, len(fleets))
+	arnValues := make([]string, 0, len(fleets))
+	models := make([]fleetModel, 0, len(fleets))
+
+	for _, fleet := range fleets {
+		arn := aws.ToString(fleet.Arn)
+
+		fleetTags, ok := tagsByARN[arn]
+		if !ok {
+			fleetTags = types.MapNull(types.StringType)
+		}
+
+		nameValues = append(nameValues, aws.ToString(fleet.Name))
+		arnValues = append(arnValues, arn)
+		models = append(models, fleetModel{
+			Name:         util.StringOrNull(fleet.Name),
+			ARN:          util.StringOrNull(fleet.Arn),
+			DisplayName:  util.StringOrNull(fleet.DisplayName),
+			Description:  util.StringOrNull(fleet.Description),
+			FleetType:    types.StringValue(string(fleet.FleetType)),
+			InstanceType: util.StringOrNull(fleet.InstanceType),
+			Platform:     types.StringValue(string(fleet.Platform)),
+			State:        types.StringValue(string(fleet.State)),
+			ImageName:    util.StringOrNull(fleet.ImageName),
+			ImageARN:     util.StringOrNull(fleet.ImageArn),
+			CreatedTime:  util.StringFromTime(fleet.CreatedTime),
+			Tags:         fleetTags,
+		})
+	}
+
+	names, d := types.ListValueFrom(ctx, types.StringType, nameValues)
+	diags.Append(d...)
+
+	arns, d = types.ListValueFrom(ctx, types.StringType, arnValues)
+	diags.Append(d...)
+
+	fleetList, d = types.ListValueFrom(ctx, fleetObjectType, models)
+	diags.Append(d...)
+
+	return names, arns, fleetList
+}
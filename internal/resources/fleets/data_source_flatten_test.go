@@ -0,0 +1,74 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenFleets(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty_is_not_null", func(t *testing.T) {
+		var diags diag.Diagnostics
+		names, arns, fleets := flattenFleets(ctx, nil, nil, &diags)
+		require.False(t, diags.HasError())
+		require.False(t, names.IsNull())
+		require.Empty(t, names.Elements())
+		require.False(t, arns.IsNull())
+		require.Empty(t, arns.Elements())
+		require.False(t, fleets.IsNull())
+		require.Empty(t, fleets.Elements())
+	})
+
+	t.Run("tags_are_attached_by_arn", func(t *testing.T) {
+		prodTags := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"env": types.StringValue("prod"),
+		})
+
+		var diags diag.Diagnostics
+		names, arns, fleets := flattenFleets(ctx, []awstypes.Fleet{
+			{
+				Name:         aws.String("a"),
+				Arn:          aws.String("arn:a"),
+				FleetType:    awstypes.FleetTypeElastic,
+				State:        awstypes.FleetStateRunning,
+				Platform:     awstypes.PlatformTypeWindowsServer2022,
+				InstanceType: aws.String("stream.standard.medium"),
+			},
+			{
+				Name:      aws.String("b"),
+				Arn:       aws.String("arn:b"),
+				FleetType: awstypes.FleetTypeOnDemand,
+				State:     awstypes.FleetStateStopped,
+			},
+		}, map[string]types.Map{"arn:a": prodTags}, &diags)
+		require.False(t, diags.HasError())
+
+		require.Equal(t, []attr.Value{types.StringValue("a"), types.StringValue("b")}, names.Elements())
+		require.Equal(t, []attr.Value{types.StringValue("arn:a"), types.StringValue("arn:b")}, arns.Elements())
+
+		var models []fleetModel
+		diags.Append(fleets.ElementsAs(ctx, &models, false)...)
+		require.False(t, diags.HasError())
+		require.Len(t, models, 2)
+
+		require.Equal(t, "ELASTIC", models[0].FleetType.ValueString())
+		require.Equal(t, "stream.standard.medium", models[0].InstanceType.ValueString())
+		require.True(t, models[0].Tags.Equal(prodTags))
+		require.True(t, models[0].ImageName.IsNull())
+
+		require.Equal(t, "STOPPED", models[1].State.ValueString())
+		require.True(t, models[1].Tags.IsNull())
+		require.True(t, models[1].CreatedTime.IsNull())
+	})
+}
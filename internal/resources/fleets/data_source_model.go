@@ -0,0 +1,42 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleets
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type dataSourceModel struct {
+	// ID is a synthetic identifier equal to the configured region.
+	ID types.String `tfsdk:"id"`
+	// NameRegex only selects fleets whose name matches the regular expression (optional).
+	NameRegex types.String `tfsdk:"name_regex"`
+	// FleetType only selects fleets of this type (optional).
+	FleetType types.String `tfsdk:"fleet_type"`
+	// State only selects fleets in this state (optional).
+	State types.String `tfsdk:"state"`
+	// InstanceType only selects fleets with this instance type (optional).
+	InstanceType types.String `tfsdk:"instance_type"`
+	// Tags only selects fleets carrying all of these tags (optional).
+	Tags types.Map `tfsdk:"tags"`
+	// Names are the names of the matching fleets (computed).
+	Names types.List `tfsdk:"names"`
+	// ARNs are the ARNs of the matching fleets (computed).
+	ARNs types.List `tfsdk:"arns"`
+	// Fleets are the key attributes of the matching fleets (computed).
+	Fleets types.List `tfsdk:"fleets"`
+}
+
+type fleetModel struct {
+	Name         types.String `tfsdk:"name"`
+	ARN          types.String `tfsdk:"arn"`
+	DisplayName  types.String `tfsdk:"display_name"`
+	Description  types.String `tfsdk:"description"`
+	FleetType    types.String `tfsdk:"fleet_type"`
+	InstanceType types.String `tfsdk:"instance_type"`
+	Platform     types.String `tfsdk:"platform"`
+	State        types.String `tfsdk:"state"`
+	ImageName    types.String `tfsdk:"image_name"`
+	ImageARN     types.String `tfsdk:"image_arn"`
+	CreatedTime  types.String `tfsdk:"created_time"`
+	Tags         types.Map    `tfsdk:"tags"`
+}
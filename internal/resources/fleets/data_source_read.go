@@ -0,0 +1,105 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/tags"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+// fleetResourceType is the Resource Groups Tagging resource type of AppStream fleets.
+const fleetResourceType = "appstream:fleet"
+
+func (ds *dataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config dataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	filter, err := newFleetFilter(&config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Configuration",
+			fmt.Sprintf("Could not compile name_regex: %v", err),
+		)
+		return
+	}
+
+	fleets, err := ds.listFleets(ctx, filter)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading AWS AppStream Fleets",
+			fmt.Sprintf("Could not list fleets: %v", err),
+		)
+		return
+	}
+
+	fleets, tagsByARN, diags := tags.FilterByResourceType(
+		ctx, ds.tags, fleetResourceType, config.Tags, fleets, func(f awstypes.Fleet) *string { return f.Arn },
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(ds.region)
+	config.Names, config.ARNs, config.Fleets = flattenFleets(ctx, fleets, tagsByARN, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listFleets pages through DescribeFleets and returns the fleets matching filter, sorted by name.
+func (ds *dataSource) listFleets(ctx context.Context, filter *fleetFilter) ([]awstypes.Fleet, error) {
+	var out []awstypes.Fleet
+	var nextToken *string
+
+	for {
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeFleets.html
+		resp, err := ds.appstreamClient.DescribeFleets(ctx, &awsappstream.DescribeFleetsInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, fleet := range resp.Fleets {
+			if filter.matches(fleet) {
+				out = append(out, fleet)
+			}
+		}
+
+		if resp.NextToken == nil || *resp.NextToken == "" {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return aws.ToString(out[i].Name) < aws.ToString(out[j].Name)
+	})
+
+	return out, nil
+}
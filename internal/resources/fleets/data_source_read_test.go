@@ -0,0 +1,89 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/fake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceRead_Tags(t *testing.T) {
+	ctx := context.Background()
+	backend := fake.New()
+
+	fleetTags := map[string]map[string]string{
+		"dev":      {"env": "dev"},
+		"prod":     {"env": "prod", "team": "core"},
+		"prod-b":   {"env": "prod"},
+		"untagged": nil,
+	}
+	for name, tags := range fleetTags {
+		_, err := backend.CreateFleet(ctx, &awsappstream.CreateFleetInput{
+			Name:            aws.String(name),
+			InstanceType:    aws.String("stream.standard.small"),
+			ImageName:       aws.String("image"),
+			ComputeCapacity: &awstypes.ComputeCapacity{DesiredInstances: aws.Int32(1)},
+			Tags:            tags,
+		})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name       string
+		attributes map[string]any
+		want       []string
+	}{
+		{
+			name: "without_tags",
+			want: []string{"dev", "prod", "prod-b", "untagged"},
+		},
+		{
+			name:       "single_tag",
+			attributes: map[string]any{"tags": map[string]string{"env": "prod"}},
+			want:       []string{"prod", "prod-b"},
+		},
+		{
+			name:       "all_tags",
+			attributes: map[string]any{"tags": map[string]string{"env": "prod", "team": "core"}},
+			want:       []string{"prod"},
+		},
+		{
+			name:       "tags_and_name_regex",
+			attributes: map[string]any{"tags": map[string]string{"env": "prod"}, "name_regex": "-b$"},
+			want:       []string{"prod-b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &dataSource{}
+			var configureResp datasource.ConfigureResponse
+			ds.Configure(ctx, datasource.ConfigureRequest{ProviderData: backend.Metadata(nil)}, &configureResp)
+			require.False(t, configureResp.Diagnostics.HasError(), "%v", configureResp.Diagnostics)
+
+			var schemaResp datasource.SchemaResponse
+			ds.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+			config := fake.Config(t, schemaResp.Schema, tt.attributes)
+
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+			ds.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var names []string
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("names"), &names)...)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			require.Equal(t, tt.want, names)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleets
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (ds *dataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List AWS AppStream Fleets",
+		MarkdownDescription: "Lists the AppStream fleets of the configured region. " +
+			"All filters are optional and combined, so only fleets matching every configured filter are returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the AppStream fleets.",
+				MarkdownDescription: "A synthetic identifier for the fleets, equal to the configured region.",
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression to match fleet names.",
+				MarkdownDescription: "A regular expression used to match AppStream fleet names. " +
+					"Uses Go regular expression syntax.",
+				Optional: true,
+				Validators: []validator.String{
+					util.ValidRegex(),
+				},
+			},
+			"fleet_type": schema.StringAttribute{
+				Description:         "Type of the AppStream fleets.",
+				MarkdownDescription: "Only return fleets of this type. Valid values are `ON_DEMAND`, `ALWAYS_ON`, or `ELASTIC`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"ON_DEMAND",
+						"ALWAYS_ON",
+						"ELASTIC",
+					),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the AppStream fleets.",
+				MarkdownDescription: "Only return fleets in this state. " +
+					"Valid values are `STARTING`, `RUNNING`, `STOPPING`, or `STOPPED`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"STARTING",
+						"RUNNING",
+						"STOPPING",
+						"STOPPED",
+					),
+				},
+			},
+			"instance_type": schema.StringAttribute{
+				Description:         "EC2 instance type of the AppStream fleets.",
+				MarkdownDescription: "Only return fleets using this EC2 instance type, for example `stream.standard.medium`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of the AppStream fleets.",
+				MarkdownDescription: "Only return fleets carrying all of these tags. " +
+					"Tags are resolved through the Resource Groups Tagging API.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"names": schema.ListAttribute{
+				Description:         "Names of the AppStream fleets.",
+				MarkdownDescription: "The names of the matching fleets, sorted by name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"arns": schema.ListAttribute{
+				Description:         "ARNs of the AppStream fleets.",
+				MarkdownDescription: "The ARNs of the matching fleets, in the same order as `names`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"fleets": schema.ListNestedAttribute{
+				Description:         "AppStream fleets.",
+				MarkdownDescription: "The key attributes of the matching fleets, in the same order as `names`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description:         "Name of the AppStream fleet.",
+							MarkdownDescription: "The name of the fleet.",
+							Computed:            true,
+						},
+						"arn": schema.StringAttribute{
+							Description:         "ARN of the AppStream fleet.",
+							MarkdownDescription: "The ARN of the fleet.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							Description:         "Display name of the AppStream fleet.",
+							MarkdownDescription: "The name displayed to users in the AppStream user interface.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							Description:         "Description of the AppStream fleet.",
+							MarkdownDescription: "The fleet description, if set.",
+							Computed:            true,
+						},
+						"fleet_type": schema.StringAttribute{
+							Description:         "Type of the AppStream fleet.",
+							MarkdownDescription: "The fleet type. Valid values are `ON_DEMAND`, `ALWAYS_ON`, or `ELASTIC`.",
+							Computed:            true,
+						},
+						"instance_type": schema.StringAttribute{
+							Description:         "EC2 instance type for fleet instances.",
+							MarkdownDescription: "The EC2 instance type used by the fleet.",
+							Computed:            true,
+						},
+						"platform": schema.StringAttribute{
+							Description:         "Fleet platform.",
+							MarkdownDescription: "The platform of the fleet.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							Description:         "State of the AppStream fleet.",
+							MarkdownDescription: "The state of the AppStream fleet.",
+							Computed:            true,
+						},
+						"image_name": schema.StringAttribute{
+							Description:         "Name of the AppStream image.",
+							MarkdownDescription: "The name of the AppStream image used to create the fleet, if set.",
+							Computed:            true,
+						},
+						"image_arn": schema.StringAttribute{
+							Description:         "ARN of the AppStream image.",
+							MarkdownDescription: "The ARN of the AppStream image used to create the fleet, if set.",
+							Computed:            true,
+						},
+						"created_time": schema.StringAttribute{
+							Description:         "Time the fleet was created.",
+							MarkdownDescription: "The timestamp when the fleet was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"tags": schema.MapAttribute{
+							Description:         "Tags applied to the AppStream fleet.",
+							MarkdownDescription: "Tags assigned to the AppStream fleet.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleets_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccFleetsDataSourceConfig(name string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_fleet" "test" {
+  name          = %[1]q
+  fleet_type    = "ON_DEMAND"
+  instance_type = "stream.standard.small"
+
+  image_name = "Amazon-AppStream2-Sample-Image-06-17-2024"
+
+  compute_capacity = {
+    desired_instances = 0
+  }
+
+  tags = {
+    TestName = %[1]q
+  }
+}
+
+data "awsappstream_fleets" "by_tag" {
+  tags = {
+    TestName = awsappstream_fleet.test.tags["TestName"]
+  }
+}
+
+data "awsappstream_fleets" "by_attributes" {
+  name_regex    = "^${awsappstream_fleet.test.name}$"
+  fleet_type    = "ON_DEMAND"
+  state         = "STOPPED"
+  instance_type = "stream.standard.small"
+}
+
+data "awsappstream_fleets" "no_match" {
+  name_regex = "^${awsappstream_fleet.test.name}$"
+  fleet_type = "ELASTIC"
+}
+`, name)
+}
+
+func TestAccFleetsDataSource_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-fleets-ds")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetsDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.awsappstream_fleets.by_tag", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.awsappstream_fleets.by_tag", "names.0", name),
+					resource.TestCheckResourceAttrPair(
+						"data.awsappstream_fleets.by_tag", "arns.0",
+						"awsappstream_fleet.test", "arn",
+					),
+					resource.TestCheckResourceAttr("data.awsappstream_fleets.by_tag", "fleets.0.tags.TestName", name),
+					resource.TestCheckResourceAttr("data.awsappstream_fleets.by_attributes", "fleets.#", "1"),
+					resource.TestCheckResourceAttr("data.awsappstream_fleets.by_attributes", "fleets.0.name", name),
+					resource.TestCheckResourceAttr("data.awsappstream_fleets.by_attributes", "fleets.0.fleet_type", "ON_DEMAND"),
+					resource.TestCheckResourceAttr("data.awsappstream_fleets.no_match", "names.#", "0"),
+				),
+			},
+		},
+	})
+}
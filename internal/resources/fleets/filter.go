@@ -0,0 +1,52 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleets
+
+import (
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+// fleetFilter selects fleets by their attributes. Empty fields match every fleet.
+type fleetFilter struct {
+	nameRegex    *regexp.Regexp
+	fleetType    awstypes.FleetType
+	state        awstypes.FleetState
+	instanceType string
+}
+
+func newFleetFilter(config *dataSourceModel) (*fleetFilter, error) {
+	f := &fleetFilter{
+		fleetType:    awstypes.FleetType(config.FleetType.ValueString()),
+		state:        awstypes.FleetState(config.State.ValueString()),
+		instanceType: config.InstanceType.ValueString(),
+	}
+
+	nameRegex, err := util.RegexpOrNil(config.NameRegex)
+	if err != nil {
+		return nil, err
+	}
+	f.nameRegex = nameRegex
+
+	return f, nil
+}
+
+func (f *fleetFilter) matches(fleet awstypes.Fleet) bool {
+	if f.nameRegex != nil && !f.nameRegex.MatchString(aws.ToString(fleet.Name)) {
+		return false
+	}
+	if f.fleetType != "" && fleet.FleetType != f.fleetType {
+		return false
+	}
+	if f.state != "" && fleet.State != f.state {
+		return false
+	}
+	if f.instanceType != "" && aws.ToString(fleet.InstanceType) != f.instanceType {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,81 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package fleets
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFleetFilter_Matches(t *testing.T) {
+	fleet := awstypes.Fleet{
+		Name:         aws.String("prod-elastic"),
+		FleetType:    awstypes.FleetTypeElastic,
+		State:        awstypes.FleetStateRunning,
+		InstanceType: aws.String("stream.standard.medium"),
+	}
+
+	tests := []struct {
+		name   string
+		config dataSourceModel
+		want   bool
+	}{
+		{
+			name:   "no_filter",
+			config: dataSourceModel{},
+			want:   true,
+		},
+		{
+			name:   "name_regex_matches",
+			config: dataSourceModel{NameRegex: types.StringValue("^prod-")},
+			want:   true,
+		},
+		{
+			name:   "name_regex_does_not_match",
+			config: dataSourceModel{NameRegex: types.StringValue("^dev-")},
+			want:   false,
+		},
+		{
+			name: "all_attributes_match",
+			config: dataSourceModel{
+				FleetType:    types.StringValue("ELASTIC"),
+				State:        types.StringValue("RUNNING"),
+				InstanceType: types.StringValue("stream.standard.medium"),
+			},
+			want: true,
+		},
+		{
+			name:   "fleet_type_does_not_match",
+			config: dataSourceModel{FleetType: types.StringValue("ALWAYS_ON")},
+			want:   false,
+		},
+		{
+			name:   "state_does_not_match",
+			config: dataSourceModel{State: types.StringValue("STOPPED")},
+			want:   false,
+		},
+		{
+			name:   "instance_type_does_not_match",
+			config: dataSourceModel{InstanceType: types.StringValue("stream.standard.large")},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newFleetFilter(&tt.config)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, f.matches(fleet))
+		})
+	}
+}
+
+func TestNewFleetFilter_InvalidRegex(t *testing.T) {
+	_, err := newFleetFilter(&dataSourceModel{NameRegex: types.StringValue("(")})
+	require.Error(t, err)
+}
@@ -6,7 +6,6 @@ package image
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -85,7 +84,7 @@ func (ds *dataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		PublicBaseImageReleasedDate: util.StringFromTime(selected.PublicBaseImageReleasedDate),
 		AppstreamAgentVersion:       util.StringOrNull(selected.AppstreamAgentVersion),
 		ImagePermissions:            flattenImagePermissions(ctx, selected.ImagePermissions, &resp.Diagnostics),
-		ImageErrors:                 flattenImageErrors(ctx, selected.ImageErrors, &resp.Diagnostics),
+		ImageErrors:                 FlattenImageErrors(ctx, selected.ImageErrors, &resp.Diagnostics),
 		LatestAppstreamAgentVersion: types.StringValue(string(selected.LatestAppstreamAgentVersion)),
 		SupportedInstanceFamilies:   util.SetStringOrNull(ctx, selected.SupportedInstanceFamilies, &resp.Diagnostics),
 		DynamicAppProvidersEnabled:  types.StringValue(string(selected.DynamicAppProvidersEnabled)),
@@ -122,13 +121,9 @@ func (ds *dataSource) listImages(ctx context.Context, config *model) ([]awstypes
 		names = []string{config.Name.ValueString()}
 	}
 
-	var regex *regexp.Regexp
-	if !config.NameRegex.IsNull() && !config.NameRegex.IsUnknown() {
-		r, err := regexp.Compile(config.NameRegex.ValueString())
-		if err != nil {
-			return nil, err
-		}
-		regex = r
+	regex, err := util.RegexpOrNil(config.NameRegex)
+	if err != nil {
+		return nil, err
 	}
 
 	var out []awstypes.Image
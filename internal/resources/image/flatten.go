@@ -54,7 +54,8 @@ var imagePermissionsObjectType = types.ObjectType{
 	},
 }
 
-var imageErrorObjectType = types.ObjectType{
+// ImageErrorObjectType is the object type of the image_errors set of images, image copies and updated images.
+var ImageErrorObjectType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
 		"error_code":      types.StringType,
 		"error_message":   types.StringType,
@@ -170,9 +171,10 @@ func flattenImagePermissions(
 	return obj
 }
 
-func flattenImageErrors(ctx context.Context, awsErrors []awstypes.ResourceError, diags *diag.Diagnostics) types.Set {
+// FlattenImageErrors converts the errors aws reports for an image. Images without errors are null.
+func FlattenImageErrors(ctx context.Context, awsErrors []awstypes.ResourceError, diags *diag.Diagnostics) types.Set {
 	if len(awsErrors) == 0 {
-		return types.SetNull(imageErrorObjectType)
+		return types.SetNull(ImageErrorObjectType)
 	}
 
 	out := make([]imageErrorModel, 0, len(awsErrors))
@@ -184,10 +186,10 @@ func flattenImageErrors(ctx context.Context, awsErrors []awstypes.ResourceError,
 		})
 	}
 
-	setVal, d := types.SetValueFrom(ctx, imageErrorObjectType, out)
+	setVal, d := types.SetValueFrom(ctx, ImageErrorObjectType, out)
 	diags.Append(d...)
 	if diags.HasError() {
-		return types.SetNull(imageErrorObjectType)
+		return types.SetNull(ImageErrorObjectType)
 	}
 
 	return setVal
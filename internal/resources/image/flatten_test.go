@@ -303,12 +303,12 @@ func TestFlattenImageErrors(t *testing.T) {
 		{
 			name:  "nil_slice_returns_null_set",
 			input: nil,
-			want:  types.SetNull(imageErrorObjectType),
+			want:  types.SetNull(ImageErrorObjectType),
 		},
 		{
 			name:  "empty_slice_returns_null_set",
 			input: []awstypes.ResourceError{},
-			want:  types.SetNull(imageErrorObjectType),
+			want:  types.SetNull(ImageErrorObjectType),
 		},
 		{
 			name: "single_error",
@@ -320,10 +320,10 @@ func TestFlattenImageErrors(t *testing.T) {
 				},
 			},
 			want: types.SetValueMust(
-				imageErrorObjectType,
+				ImageErrorObjectType,
 				[]attr.Value{
 					types.ObjectValueMust(
-						imageErrorObjectType.AttrTypes,
+						ImageErrorObjectType.AttrTypes,
 						map[string]attr.Value{
 							"error_code":      types.StringValue("IMAGE_NOT_FOUND"),
 							"error_message":   types.StringValue("image missing"),
@@ -339,7 +339,7 @@ func TestFlattenImageErrors(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var diags diag.Diagnostics
 
-			got := flattenImageErrors(ctx, tt.input, &diags)
+			got := FlattenImageErrors(ctx, tt.input, &diags)
 
 			if diags.HasError() {
 				t.Fatalf("unexpected diagnostics: %v", diags)
@@ -0,0 +1,76 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+)
+
+// imageReferences returns the names of the fleets and image builders that use the image.
+func (r *resource) imageReferences(ctx context.Context, name, arn string) (fleets, imageBuilders []string, err error) {
+	var nextToken *string
+	for {
+		out, err := r.appstreamClient.DescribeFleets(ctx, &awsappstream.DescribeFleetsInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		fleets = append(fleets, fleetsUsingImage(out.Fleets, name, arn)...)
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	nextToken = nil
+	for {
+		out, err := r.appstreamClient.DescribeImageBuilders(ctx, &awsappstream.DescribeImageBuildersInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		imageBuilders = append(imageBuilders, imageBuildersUsingImage(out.ImageBuilders, arn)...)
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	sort.Strings(fleets)
+	sort.Strings(imageBuilders)
+
+	return fleets, imageBuilders, nil
+}
+
+func fleetsUsingImage(fleets []awstypes.Fleet, name, arn string) []string {
+	var out []string
+	for _, f := range fleets {
+		// fleets report the image by name, by arn or both
+		if (f.ImageName != nil && *f.ImageName == name) || (arn != "" && aws.ToString(f.ImageArn) == arn) {
+			out = append(out, aws.ToString(f.Name))
+		}
+	}
+	return out
+}
+
+func imageBuildersUsingImage(imageBuilders []awstypes.ImageBuilder, arn string) []string {
+	var out []string
+	for _, ib := range imageBuilders {
+		if arn != "" && aws.ToString(ib.ImageArn) == arn {
+			out = append(out, aws.ToString(ib.Name))
+		}
+	}
+	return out
+}
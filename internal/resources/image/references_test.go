@@ -0,0 +1,64 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testImageARN = "arn:aws:appstream:eu-west-1:123456789012:image/image"
+
+func TestFleetsUsingImage(t *testing.T) {
+	fleets := []awstypes.Fleet{
+		{Name: aws.String("by-name"), ImageName: aws.String("image")},
+		{Name: aws.String("by-arn"), ImageArn: aws.String(testImageARN)},
+		{Name: aws.String("other"), ImageName: aws.String("other-image")},
+		{Name: aws.String("none")},
+	}
+
+	tests := []struct {
+		name      string
+		imageName string
+		imageARN  string
+		want      []string
+	}{
+		{
+			name:      "name_and_arn",
+			imageName: "image",
+			imageARN:  testImageARN,
+			want:      []string{"by-name", "by-arn"},
+		},
+		{
+			name:      "unknown_arn",
+			imageName: "image",
+			want:      []string{"by-name"},
+		},
+		{
+			name:      "unused",
+			imageName: "unused",
+			imageARN:  "arn:aws:appstream:eu-west-1:123456789012:image/unused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, fleetsUsingImage(fleets, tt.imageName, tt.imageARN))
+		})
+	}
+}
+
+func TestImageBuildersUsingImage(t *testing.T) {
+	imageBuilders := []awstypes.ImageBuilder{
+		{Name: aws.String("builder"), ImageArn: aws.String(testImageARN)},
+		{Name: aws.String("other"), ImageArn: aws.String("arn:aws:appstream:eu-west-1:123456789012:image/other")},
+		{Name: aws.String("none")},
+	}
+
+	require.Equal(t, []string{"builder"}, imageBuildersUsingImage(imageBuilders, testImageARN))
+	require.Nil(t, imageBuildersUsingImage(imageBuilders, ""))
+}
@@ -0,0 +1,33 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image
+
+import (
+	"context"
+	"fmt"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Create never creates an image. images are produced by image builders and adopted through import.
+func (r *resource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	var plan resourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	resp.Diagnostics.AddError(
+		"AWS AppStream Image Must Be Imported",
+		fmt.Sprintf(
+			"AppStream images are created by image builders and cannot be created by this resource. "+
+				"To manage the existing image %q with Terraform, import it using:\n\n"+
+				"  terraform import <resource_address> %q",
+			name, name,
+		),
+	)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	var state resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	if state.Name.IsNull() || state.Name.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform State",
+			"Cannot delete image because name must be known.",
+		)
+		return
+	}
+
+	name := state.Name.ValueString()
+
+	if !state.ForceDelete.ValueBool() {
+		fleets, imageBuilders, err := r.imageReferences(ctx, name, state.ARN.ValueString())
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Deleting AWS AppStream Image",
+				fmt.Sprintf("Could not check whether image %q is in use: %v", name, err),
+			)
+			return
+		}
+
+		var usedBy []string
+		if len(fleets) > 0 {
+			usedBy = append(usedBy, "fleets "+strings.Join(fleets, ", "))
+		}
+		if len(imageBuilders) > 0 {
+			usedBy = append(usedBy, "image builders "+strings.Join(imageBuilders, ", "))
+		}
+
+		if len(usedBy) > 0 {
+			resp.Diagnostics.AddError(
+				"AWS AppStream Image In Use",
+				fmt.Sprintf(
+					"Image %q is still used by %s. Move them to another image first or set `force_delete = true`.",
+					name, strings.Join(usedBy, " and "),
+				),
+			)
+			return
+		}
+	}
+
+	// an image that is already gone is fine for delete.
+	if err := DeleteImage(ctx, r.appstreamClient, name); err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting AWS AppStream Image",
+			fmt.Sprintf("Could not delete image %q: %v", name, err),
+		)
+		return
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/fake"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceDelete_ImageInUse(t *testing.T) {
+	tests := []struct {
+		name        string
+		forceDelete bool
+		wantError   string
+	}{
+		{
+			name:      "in_use",
+			wantError: "AWS AppStream Image In Use",
+		},
+		{
+			name:        "force_delete",
+			forceDelete: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withoutImageWait(t)
+
+			ctx := context.Background()
+			backend := fake.New()
+			image := backend.AddImage("image")
+
+			_, err := backend.CreateFleet(ctx, &awsappstream.CreateFleetInput{
+				Name:            aws.String("fleet"),
+				InstanceType:    aws.String("stream.standard.small"),
+				ImageName:       aws.String("image"),
+				ComputeCapacity: &awstypes.ComputeCapacity{DesiredInstances: aws.Int32(1)},
+			})
+			require.NoError(t, err)
+
+			_, err = backend.CreateImageBuilder(ctx, &awsappstream.CreateImageBuilderInput{
+				Name:         aws.String("builder"),
+				InstanceType: aws.String("stream.standard.small"),
+				ImageArn:     image.Arn,
+			})
+			require.NoError(t, err)
+
+			r := &resource{}
+			var configureResp tfresource.ConfigureResponse
+			r.Configure(ctx, tfresource.ConfigureRequest{ProviderData: backend.Metadata(nil)}, &configureResp)
+			require.False(t, configureResp.Diagnostics.HasError(), "%v", configureResp.Diagnostics)
+
+			var schemaResp tfresource.SchemaResponse
+			r.Schema(ctx, tfresource.SchemaRequest{}, &schemaResp)
+
+			state := fake.State(t, schemaResp.Schema, map[string]any{
+				"id":           "image",
+				"name":         "image",
+				"arn":          aws.ToString(image.Arn),
+				"force_delete": tt.forceDelete,
+			})
+
+			var resp tfresource.DeleteResponse
+			r.Delete(ctx, tfresource.DeleteRequest{State: state}, &resp)
+
+			_, err = backend.DescribeImages(ctx, &awsappstream.DescribeImagesInput{Names: []string{"image"}})
+
+			if tt.wantError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				require.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+				require.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "fleets fleet and image builders builder")
+				require.NoError(t, err)
+				return
+			}
+
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			require.True(t, util.IsResourceNotFoundException(err))
+		})
+	}
+}
+
+func withoutImageWait(t *testing.T) {
+	t.Helper()
+
+	initBackoff, maxBackoff := imageWaitInitBackoff, imageWaitMaxBackoff
+	imageWaitInitBackoff, imageWaitMaxBackoff = time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		imageWaitInitBackoff, imageWaitMaxBackoff = initBackoff, maxBackoff
+	})
+}
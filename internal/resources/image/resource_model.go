@@ -0,0 +1,69 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type resourceModel struct {
+	// ID is the identifier of the image.
+	// This is equal to the image name (computed).
+	ID types.String `tfsdk:"id"`
+	// Name is the name of the private AppStream image (required).
+	Name types.String `tfsdk:"name"`
+	// ForceDelete allows deleting the image while fleets or image builders reference it (optional, computed).
+	ForceDelete types.Bool `tfsdk:"force_delete"`
+	// Tags is a map of tags assigned to the image (optional).
+	Tags types.Map `tfsdk:"tags"`
+	// TagsAll is the map of all tags of the image, including provider default tags (computed).
+	TagsAll types.Map `tfsdk:"tags_all"`
+	// ARN is the ARN of the AppStream image (computed).
+	ARN types.String `tfsdk:"arn"`
+	// Visibility is the visibility of the image (computed).
+	Visibility types.String `tfsdk:"visibility"`
+	// BaseImageARN is the ARN of the image from which this image was created (computed).
+	BaseImageARN types.String `tfsdk:"base_image_arn"`
+	// DisplayName is the name displayed to users for the image (computed).
+	DisplayName types.String `tfsdk:"display_name"`
+	// State is the current lifecycle state of the image (computed).
+	State types.String `tfsdk:"state"`
+	// ImageBuilderSupported indicates whether an image builder can be launched
+	// from this image (computed).
+	ImageBuilderSupported types.Bool `tfsdk:"image_builder_supported"`
+	// ImageBuilderName is the name of the image builder used to create the image,
+	// if applicable (computed).
+	ImageBuilderName types.String `tfsdk:"image_builder_name"`
+	// Platform is the operating system platform of the image (computed).
+	Platform types.String `tfsdk:"platform"`
+	// Description is the image description, if set (computed).
+	Description types.String `tfsdk:"description"`
+	// StateChangeReason describes why the image last changed state (computed).
+	StateChangeReason types.Object `tfsdk:"state_change_reason"`
+	// Applications is the set of applications included in the image (computed).
+	Applications types.Set `tfsdk:"applications"`
+	// CreatedTime is the timestamp when the image was created (computed).
+	CreatedTime types.String `tfsdk:"created_time"`
+	// PublicBaseImageReleasedDate is the release date of the public base image
+	// used to create this image (computed).
+	PublicBaseImageReleasedDate types.String `tfsdk:"public_base_image_released_date"`
+	// AppstreamAgentVersion is the AppStream agent version used by the image (computed).
+	AppstreamAgentVersion types.String `tfsdk:"appstream_agent_version"`
+	// ImagePermissions describes permissions granted for the image (computed).
+	ImagePermissions types.Object `tfsdk:"image_permissions"`
+	// ImageErrors is the list of errors reported by AWS for the image (computed).
+	ImageErrors types.Set `tfsdk:"image_errors"`
+	// LatestAppstreamAgentVersion indicates whether the image uses the latest
+	// AppStream agent version (computed).
+	LatestAppstreamAgentVersion types.String `tfsdk:"latest_appstream_agent_version"`
+	// SupportedInstanceFamilies lists the instance families supported by the image (computed).
+	SupportedInstanceFamilies types.Set `tfsdk:"supported_instance_families"`
+	// DynamicAppProvidersEnabled indicates whether dynamic app providers
+	// are enabled for the image (computed).
+	DynamicAppProvidersEnabled types.String `tfsdk:"dynamic_app_providers_enabled"`
+	// ImageSharedWithOthers indicates whether the image is shared with other AWS accounts (computed).
+	ImageSharedWithOthers types.String `tfsdk:"image_shared_with_others"`
+	// ManagedSoftwareIncluded indicates whether the image includes managed software (computed).
+	ManagedSoftwareIncluded types.Bool `tfsdk:"managed_software_included"`
+	// ImageType is the type of the image: CUSTOM or NATIVE (computed).
+	ImageType types.String `tfsdk:"image_type"`
+}
@@ -0,0 +1,130 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Read(ctx context.Context, req tfresource.ReadRequest, resp *tfresource.ReadResponse) {
+	var state resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	newState, diags := r.readImage(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *resource) readImage(ctx context.Context, prior resourceModel) (*resourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name := prior.Name.ValueString()
+
+	image, err := DescribeImage(ctx, r.appstreamClient, name)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return nil, diags
+		}
+
+		diags.AddError(
+			"Error Reading AWS AppStream Image",
+			fmt.Sprintf("Could not read image %q: %v", name, err),
+		)
+		return nil, diags
+	}
+
+	if image == nil {
+		return nil, diags
+	}
+
+	// public and shared images belong to other accounts and cannot be tagged or deleted
+	if image.Visibility != awstypes.VisibilityTypePrivate {
+		diags.AddError(
+			"Unsupported AWS AppStream Image",
+			fmt.Sprintf(
+				"Image %q has visibility %s. Only private images owned by this account can be managed. "+
+					"Use the awsappstream_image data source to reference it instead.",
+				name, image.Visibility,
+			),
+		)
+		return nil, diags
+	}
+
+	// force_delete is not an aws attribute and is unknown after an import
+	forceDelete := prior.ForceDelete
+	if forceDelete.IsNull() || forceDelete.IsUnknown() {
+		forceDelete = types.BoolValue(false)
+	}
+
+	state := &resourceModel{
+		ID:                          types.StringValue(aws.ToString(image.Name)),
+		Name:                        types.StringValue(aws.ToString(image.Name)),
+		ForceDelete:                 forceDelete,
+		Tags:                        types.MapNull(types.StringType),
+		TagsAll:                     types.MapNull(types.StringType),
+		ARN:                         util.StringOrNull(image.Arn),
+		Visibility:                  types.StringValue(string(image.Visibility)),
+		BaseImageARN:                util.StringOrNull(image.BaseImageArn),
+		DisplayName:                 util.StringOrNull(image.DisplayName),
+		State:                       types.StringValue(string(image.State)),
+		ImageBuilderSupported:       util.BoolOrNull(image.ImageBuilderSupported),
+		ImageBuilderName:            util.StringOrNull(image.ImageBuilderName),
+		Platform:                    types.StringValue(string(image.Platform)),
+		Description:                 util.StringOrNull(image.Description),
+		StateChangeReason:           flattenStateChangeReason(ctx, image.StateChangeReason, &diags),
+		Applications:                flattenApplications(ctx, image.Applications, &diags),
+		CreatedTime:                 util.StringFromTime(image.CreatedTime),
+		PublicBaseImageReleasedDate: util.StringFromTime(image.PublicBaseImageReleasedDate),
+		AppstreamAgentVersion:       util.StringOrNull(image.AppstreamAgentVersion),
+		ImagePermissions:            flattenImagePermissions(ctx, image.ImagePermissions, &diags),
+		ImageErrors:                 FlattenImageErrors(ctx, image.ImageErrors, &diags),
+		LatestAppstreamAgentVersion: types.StringValue(string(image.LatestAppstreamAgentVersion)),
+		SupportedInstanceFamilies:   util.SetStringOrNull(ctx, image.SupportedInstanceFamilies, &diags),
+		DynamicAppProvidersEnabled:  types.StringValue(string(image.DynamicAppProvidersEnabled)),
+		ImageSharedWithOthers:       types.StringValue(string(image.ImageSharedWithOthers)),
+		ManagedSoftwareIncluded:     util.BoolOrNull(image.ManagedSoftwareIncluded),
+		ImageType:                   types.StringValue(string(image.ImageType)),
+	}
+
+	if !state.ARN.IsNull() {
+		tags, tagsAll, tagDiags := r.tags.ReadResource(ctx, state.ARN.ValueString(), prior.Tags)
+		diags.Append(tagDiags...)
+		state.Tags = tags
+		state.TagsAll = tagsAll
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return state, diags
+}
@@ -0,0 +1,147 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+// the helpers below are shared with the image copy and updated image resources, which create images
+// through other apis but read, wait for and delete them the same way.
+
+var (
+	ErrUnexpectedImageState = errors.New("unexpected image state")
+	ErrImageFailed          = errors.New("image did not become available")
+)
+
+// DescribeImage returns the image or nil if it does not exist.
+func DescribeImage(ctx context.Context, client metadata.AppStreamAPI, name string) (*awstypes.Image, error) {
+	out, err := client.DescribeImages(ctx, &awsappstream.DescribeImagesInput{
+		Names: []string{name},
+	})
+	if err != nil {
+		if util.IsAppStreamNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if len(out.Images) == 0 {
+		return nil, nil
+	}
+
+	return &out.Images[0], nil
+}
+
+// WaitImageAvailable waits until a newly created image is available.
+func WaitImageAvailable(ctx context.Context, client metadata.AppStreamAPI, name string) error {
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			image, err := DescribeImage(ctx, client, name)
+			if err != nil {
+				return err
+			}
+
+			if image == nil {
+				// new images are not visible right away
+				return fmt.Errorf("%w: current=not found", ErrUnexpectedImageState)
+			}
+
+			switch image.State {
+			case awstypes.ImageStateAvailable:
+				return nil
+
+			case awstypes.ImageStateFailed, awstypes.ImageStateDeleting:
+				// terminal states. only delete is possible
+				return fmt.Errorf("%w: state=%s: %s", ErrImageFailed, image.State, FormatImageErrors(image.ImageErrors))
+
+			default:
+				// wait for pending, creating or copying to settle
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedImageState, image.State)
+			}
+		},
+		util.WithTimeout(imageWaitTimeout),
+		util.WithInitBackoff(imageWaitInitBackoff),
+		util.WithMaxBackoff(imageWaitMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeImages.html
+		util.WithRetryOnFns(
+			func(err error) bool {
+				return errors.Is(err, ErrUnexpectedImageState)
+			},
+		),
+	)
+}
+
+// DeleteImage deletes the image once it is no longer being created and waits until it is gone.
+func DeleteImage(ctx context.Context, client metadata.AppStreamAPI, name string) error {
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			image, err := DescribeImage(ctx, client, name)
+			if err != nil {
+				return err
+			}
+
+			if image == nil {
+				// already deleted
+				return nil
+			}
+
+			switch image.State {
+			case awstypes.ImageStateAvailable, awstypes.ImageStateFailed:
+				// deletable state
+				_, err = client.DeleteImage(ctx, &awsappstream.DeleteImageInput{
+					Name: aws.String(name),
+				})
+				if err != nil {
+					if util.IsAppStreamNotFound(err) {
+						// already deleted
+						return nil
+					}
+					return err
+				}
+				// wait until the image is gone
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedImageState, image.State)
+
+			default:
+				// wait for pending, creating, copying or deleting to settle
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedImageState, image.State)
+			}
+		},
+		util.WithTimeout(imageWaitTimeout),
+		util.WithInitBackoff(imageWaitInitBackoff),
+		util.WithMaxBackoff(imageWaitMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeImages.html
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DeleteImage.html
+		util.WithRetryOnFns(
+			func(err error) bool {
+				return errors.Is(err, ErrUnexpectedImageState)
+			},
+			util.IsConcurrentModificationException,
+		),
+	)
+}
+
+// FormatImageErrors joins the errors aws reports for an image into a single message.
+func FormatImageErrors(imageErrors []awstypes.ResourceError) string {
+	if len(imageErrors) == 0 {
+		return "no errors reported by aws"
+	}
+
+	msgs := make([]string, 0, len(imageErrors))
+	for _, e := range imageErrors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", e.ErrorCode, aws.ToString(e.ErrorMessage)))
+	}
+	return strings.Join(msgs, "; ")
+}
@@ -0,0 +1,23 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatImageErrors(t *testing.T) {
+	require.Equal(t, "no errors reported by aws", FormatImageErrors(nil))
+	require.Equal(t,
+		"INTERNAL_SERVICE_ERROR: copy failed; IAM_SERVICE_ROLE_MISSING_ENI_DESCRIBE_ACTION: ",
+		FormatImageErrors([]awstypes.ResourceError{
+			{ErrorCode: awstypes.FleetErrorCodeInternalServiceError, ErrorMessage: aws.String("copy failed")},
+			{ErrorCode: awstypes.FleetErrorCodeIamServiceRoleMissingEniDescribeAction},
+		}),
+	)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccImageResourceConfig(name string) string {
+	return testhelpers.TestAccProviderBasicConfig() + `
+resource "awsappstream_image" "test" {
+  name = "` + name + `"
+}
+`
+}
+
+func TestAccImage_createRequiresImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccImageResourceConfig("tf-acc-image-not-imported"),
+				ExpectError: regexp.MustCompile(`AWS AppStream Image Must Be Imported`),
+			},
+		},
+	})
+}
+
+func TestAccImage_importPublicImage(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccImageResourceConfig(testAccPublicImageName),
+				ResourceName:  "awsappstream_image.test",
+				ImportState:   true,
+				ImportStateId: testAccPublicImageName,
+				ExpectError:   regexp.MustCompile(`Unsupported AWS AppStream Image`),
+			},
+		},
+	})
+}
@@ -0,0 +1,14 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image
+
+import "time"
+
+// variables so that tests against the fake backend can wait for state changes without delay
+var (
+	// copying large images across regions or installing updates can take well over an hour
+	imageWaitTimeout     = 3 * time.Hour
+	imageWaitInitBackoff = 1 * time.Minute
+	imageWaitMaxBackoff  = 5 * time.Minute
+)
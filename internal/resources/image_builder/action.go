@@ -0,0 +1,66 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_builder
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+)
+
+var (
+	_ action.Action              = &stateAction{}
+	_ action.ActionWithConfigure = &stateAction{}
+)
+
+// NewStartAction returns the action that starts an image builder and waits until it is running.
+func NewStartAction() action.Action {
+	return &stateAction{target: awstypes.ImageBuilderStateRunning}
+}
+
+// NewStopAction returns the action that stops an image builder and waits until it is stopped.
+func NewStopAction() action.Action {
+	return &stateAction{target: awstypes.ImageBuilderStateStopped}
+}
+
+type stateAction struct {
+	appstreamClient metadata.AppStreamAPI
+	target          awstypes.ImageBuilderState
+}
+
+func (a *stateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	if a.target == awstypes.ImageBuilderStateRunning {
+		resp.TypeName = req.ProviderTypeName + "_start_image_builder"
+		return
+	}
+	resp.TypeName = req.ProviderTypeName + "_stop_image_builder"
+}
+
+func (a *stateAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*metadata.Metadata)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *Metadata, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if meta.Appstream == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			"Expected *Metadata.Appstream, got: nil. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	a.appstreamClient = meta.Appstream
+}
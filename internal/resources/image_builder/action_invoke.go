@@ -0,0 +1,51 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_builder
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (a *stateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config stateActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	name := config.Name.ValueString()
+
+	progress := func(msg string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: msg})
+	}
+
+	if err := ensureImageBuilderState(ctx, a.appstreamClient, name, a.target, progress); err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		summary, verb := "Error Starting AWS AppStream Image Builder", "start"
+		if a.target == awstypes.ImageBuilderStateStopped {
+			summary, verb = "Error Stopping AWS AppStream Image Builder", "stop"
+		}
+
+		resp.Diagnostics.AddError(
+			summary,
+			fmt.Sprintf("Could not %s image builder %q: %v", verb, name, err),
+		)
+		return
+	}
+
+	progress(fmt.Sprintf("image builder %q is %s", name, a.target))
+}
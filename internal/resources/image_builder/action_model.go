@@ -0,0 +1,10 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_builder
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type stateActionModel struct {
+	Name types.String `tfsdk:"name"`
+}
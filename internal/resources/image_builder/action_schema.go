@@ -0,0 +1,46 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_builder
+
+import (
+	"context"
+	"regexp"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (a *stateAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	description := "Start an AWS AppStream Image Builder"
+	markdownDescription := "Starts an AppStream image builder and waits until it is `RUNNING`. " +
+		"Nothing is written to the Terraform state, so the image builder can be started as a one-off operation, " +
+		"for example before installing applications with the Image Assistant."
+	if a.target == awstypes.ImageBuilderStateStopped {
+		description = "Stop an AWS AppStream Image Builder"
+		markdownDescription = "Stops an AppStream image builder and waits until it is `STOPPED`. " +
+			"Nothing is written to the Terraform state, so the image builder can be stopped as a one-off operation, " +
+			"for example to save costs once an image has been created. Failed image builders cannot be started or stopped."
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: markdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:         "Name of the AppStream image builder.",
+				MarkdownDescription: "The name of the AppStream image builder.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_builder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccImageBuilderActionConfig(actionType, name string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+action %[1]q "test" {
+  config {
+    name = %[2]q
+  }
+}
+
+resource "terraform_data" "test" {
+  input = %[1]q
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.%[1]s.test]
+    }
+  }
+}
+`, actionType, name)
+}
+
+func TestAccImageBuilderActions_basic(t *testing.T) {
+	name := testhelpers.TestAccImageBuilderName(t)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageBuilderActionConfig("awsappstream_stop_image_builder", name),
+			},
+			{
+				Config: testAccImageBuilderActionConfig("awsappstream_start_image_builder", name),
+			},
+		},
+	})
+}
@@ -0,0 +1,19 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_builder
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ephemeralResourceModel struct {
+	// Name is the name of the image builder to stream from (required).
+	Name types.String `tfsdk:"name"`
+	// Validity is the time in seconds the URL is valid for (optional).
+	Validity types.Int64 `tfsdk:"validity"`
+	// StartImageBuilder starts a stopped image builder and waits until it is running (optional).
+	StartImageBuilder types.Bool `tfsdk:"start_image_builder"`
+	// StreamingURL is the URL that starts the streaming session (computed).
+	StreamingURL types.String `tfsdk:"streaming_url"`
+	// Expires is the time the URL expires (computed).
+	Expires types.String `tfsdk:"expires"`
+}
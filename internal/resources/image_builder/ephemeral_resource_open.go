@@ -0,0 +1,70 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_builder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (e *ephemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config ephemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	name := config.Name.ValueString()
+
+	if config.StartImageBuilder.ValueBool() {
+		if err := ensureImageBuilderState(ctx, e.appstreamClient, name, awstypes.ImageBuilderStateRunning, nil); err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Starting AWS AppStream Image Builder",
+				fmt.Sprintf("Could not start image builder %q: %v", name, err),
+			)
+			return
+		}
+	}
+
+	input := &awsappstream.CreateImageBuilderStreamingURLInput{
+		Name: aws.String(name),
+	}
+	if !config.Validity.IsNull() && !config.Validity.IsUnknown() {
+		input.Validity = aws.Int64(config.Validity.ValueInt64())
+	}
+
+	// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_CreateImageBuilderStreamingURL.html
+	out, err := e.appstreamClient.CreateImageBuilderStreamingURL(ctx, input)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating AWS AppStream Image Builder Streaming URL",
+			fmt.Sprintf("Could not create streaming URL for image builder %q: %v", name, err),
+		)
+		return
+	}
+
+	config.StreamingURL = util.StringOrNull(out.StreamingURL)
+	config.Expires = util.StringFromTime(out.Expires)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_builder
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (e *ephemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create a temporary AWS AppStream image builder streaming URL",
+		MarkdownDescription: "Creates a temporary URL to connect to an AppStream image builder, for example to install applications " +
+			"with the Image Assistant. The URL is never written to the Terraform plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:         "Name of the AppStream image builder.",
+				MarkdownDescription: "The name of the AppStream image builder to connect to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"validity": schema.Int64Attribute{
+				Description:         "Validity of the URL in seconds.",
+				MarkdownDescription: "The time in seconds the streaming URL is valid for, between `1` and `604800`. AWS defaults to `3600` seconds.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 604800),
+				},
+			},
+			"start_image_builder": schema.BoolAttribute{
+				Description: "Whether to start the image builder.",
+				MarkdownDescription: "Whether to start the image builder if it is stopped and wait until it is `RUNNING` before the URL is created. " +
+					"Ephemeral resources are opened during plan as well, so a plan can start the image builder. Defaults to `false`.",
+				Optional: true,
+			},
+			"streaming_url": schema.StringAttribute{
+				Description:         "Streaming URL.",
+				MarkdownDescription: "The URL that starts the streaming session to the image builder.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires": schema.StringAttribute{
+				Description:         "Expiry of the URL.",
+				MarkdownDescription: "The time the streaming URL expires, in RFC3339 format.",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_builder_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccImageBuilderStreamingURLConfig(name string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+ephemeral "awsappstream_image_builder_streaming_url" "test" {
+  name                = %q
+  validity            = 300
+  start_image_builder = true
+}
+
+provider "echo" {
+  data = ephemeral.awsappstream_image_builder_streaming_url.test
+}
+
+resource "echo" "test" {}
+`, name)
+}
+
+func TestAccImageBuilderStreamingURLEphemeral_basic(t *testing.T) {
+	name := testhelpers.TestAccImageBuilderName(t)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactoriesWithEcho,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageBuilderStreamingURLConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("streaming_url"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^https://`)),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("expires"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
@@ -230,3 +230,29 @@ func flattenImageBuilderErrors(
 
 	return setVal
 }
+
+func flattenDesiredState(prior types.String, awsState awstypes.ImageBuilderState) types.String {
+	// user never set it
+	if prior.IsNull() {
+		return types.StringNull()
+	}
+
+	// terraform does not yet know
+	if prior.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	// transitional states count towards the state they settle in
+	switch awsState {
+	case awstypes.ImageBuilderStateRunning,
+		awstypes.ImageBuilderStatePending,
+		awstypes.ImageBuilderStateRebooting,
+		awstypes.ImageBuilderStateSnapshotting,
+		awstypes.ImageBuilderStateUpdatingAgent:
+		return types.StringValue(string(awstypes.ImageBuilderStateRunning))
+	case awstypes.ImageBuilderStateStopped, awstypes.ImageBuilderStateStopping:
+		return types.StringValue(string(awstypes.ImageBuilderStateStopped))
+	default:
+		return prior
+	}
+}
@@ -362,3 +362,65 @@ func TestFlattenImageBuilderErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenDesiredState(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior types.String
+		in    awstypes.ImageBuilderState
+		want  types.String
+	}{
+		{
+			name:  "prior_null",
+			prior: types.StringNull(),
+			in:    awstypes.ImageBuilderStateRunning,
+			want:  types.StringNull(),
+		},
+		{
+			name:  "prior_unknown",
+			prior: types.StringUnknown(),
+			in:    awstypes.ImageBuilderStateRunning,
+			want:  types.StringUnknown(),
+		},
+		{
+			name:  "running",
+			prior: types.StringValue("RUNNING"),
+			in:    awstypes.ImageBuilderStateRunning,
+			want:  types.StringValue("RUNNING"),
+		},
+		{
+			name:  "pending_counts_as_running",
+			prior: types.StringValue("RUNNING"),
+			in:    awstypes.ImageBuilderStatePending,
+			want:  types.StringValue("RUNNING"),
+		},
+		{
+			name:  "stopping_counts_as_stopped",
+			prior: types.StringValue("RUNNING"),
+			in:    awstypes.ImageBuilderStateStopping,
+			want:  types.StringValue("STOPPED"),
+		},
+		{
+			name:  "drift_detected",
+			prior: types.StringValue("STOPPED"),
+			in:    awstypes.ImageBuilderStateRunning,
+			want:  types.StringValue("RUNNING"),
+		},
+		{
+			name:  "failed_keeps_prior",
+			prior: types.StringValue("STOPPED"),
+			in:    awstypes.ImageBuilderStateFailed,
+			want:  types.StringValue("STOPPED"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := flattenDesiredState(tt.prior, tt.in)
+
+			if !got.Equal(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
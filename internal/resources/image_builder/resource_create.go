@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
 )
@@ -117,9 +118,26 @@ func (r *resource) Create(ctx context.Context, req tfresource.CreateRequest, res
 		}
 	}
 
+	// new image builders start on their own. wait for that before stopping or handing them to dependents
+	err = ensureImageBuilderState(ctx, r.appstreamClient, name, awstypes.ImageBuilderStateRunning, nil)
+	if err == nil && !plan.DesiredState.IsNull() && !plan.DesiredState.IsUnknown() {
+		err = ensureImageBuilderState(ctx, r.appstreamClient, name, awstypes.ImageBuilderState(plan.DesiredState.ValueString()), nil)
+	}
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Waiting for AWS AppStream Image Builder",
+			fmt.Sprintf("Image builder %q did not reach the desired state: %v", name, err),
+		)
+		// keep the image builder in state so terraform taints it and deletes it on the next apply
+	}
+
 	newState, diags := r.readImageBuilder(ctx, plan)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if diags.HasError() {
 		return
 	}
 
@@ -36,8 +36,12 @@ type resourceModel struct {
 	AccessEndpoints types.Set `tfsdk:"access_endpoints"`
 	// RootVolumeConfig specifies the root volume configuration for the image builder (optional, computed).
 	RootVolumeConfig types.Object `tfsdk:"root_volume_config"`
+	// DesiredState is the running state the image builder should converge to: RUNNING or STOPPED (optional).
+	DesiredState types.String `tfsdk:"desired_state"`
 	// Tags is a map of tags assigned to the image builder (optional).
 	Tags types.Map `tfsdk:"tags"`
+	// TagsAll is the map of all tags assigned to the image builder, including provider default tags (computed).
+	TagsAll types.Map `tfsdk:"tags_all"`
 	// ARN is the ARN of the AppStream image builder (computed).
 	ARN types.String `tfsdk:"arn"`
 	// CreatedTime is the timestamp when the image builder was created (computed).
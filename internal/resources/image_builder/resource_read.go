@@ -101,7 +101,9 @@ func (r *resource) readImageBuilder(ctx context.Context, prior resourceModel) (*
 		AppstreamAgentVersion:       util.StringOrNull(imageBuilder.AppstreamAgentVersion),
 		AccessEndpoints:             flattenAccessEndpoints(ctx, imageBuilder.AccessEndpoints, &diags),
 		RootVolumeConfig:            flattenRootVolumeConfig(ctx, imageBuilder.RootVolumeConfig, &diags),
+		DesiredState:                flattenDesiredState(prior.DesiredState, imageBuilder.State),
 		Tags:                        types.MapNull(types.StringType),
+		TagsAll:                     types.MapNull(types.StringType),
 		ARN:                         util.StringOrNull(imageBuilder.Arn),
 		CreatedTime:                 util.StringFromTime(imageBuilder.CreatedTime),
 		Platform:                    types.StringValue(string(imageBuilder.Platform)),
@@ -113,9 +115,10 @@ func (r *resource) readImageBuilder(ctx context.Context, prior resourceModel) (*
 	}
 
 	if !state.ARN.IsNull() {
-		tags, tagDiags := r.tags.Read(ctx, state.ARN.ValueString())
+		tags, tagsAll, tagDiags := r.tags.ReadResource(ctx, state.ARN.ValueString(), prior.Tags)
 		diags.Append(tagDiags...)
 		state.Tags = tags
+		state.TagsAll = tagsAll
 	}
 
 	if diags.HasError() {
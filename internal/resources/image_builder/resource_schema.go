@@ -254,6 +254,17 @@ func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *t
 					},
 				},
 			},
+			"desired_state": schema.StringAttribute{
+				Description: "Desired running state of the image builder.",
+				MarkdownDescription: "The running state the image builder should converge to. Valid values are `RUNNING` or `STOPPED`. " +
+					"The provider always waits for a new image builder to reach `RUNNING`. When set, the provider then " +
+					"starts or stops the image builder during create and update and waits until it reaches this state. " +
+					"Use `STOPPED` to park idle image builders. If not set, the running state is not managed after creation.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("RUNNING", "STOPPED"),
+				},
+			},
 			"tags": schema.MapAttribute{
 				Description:         "Tags applied to the AppStream image builder.",
 				MarkdownDescription: "A map of tags assigned to the AppStream image builder.",
@@ -277,6 +288,13 @@ func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *t
 					),
 				},
 			},
+			"tags_all": schema.MapAttribute{
+				Description: "All tags of the AppStream image builder, including provider default tags.",
+				MarkdownDescription: "A map of all tags assigned to the AppStream image builder, " +
+					"including those inherited from the provider `default_tags`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"arn": schema.StringAttribute{
 				Description:         "ARN of the AppStream image builder.",
 				MarkdownDescription: "The Amazon Resource Name (ARN) of the AppStream image builder.",
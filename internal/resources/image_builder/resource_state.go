@@ -0,0 +1,112 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_builder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var ErrImageBuilderFailed = errors.New("image builder failed")
+
+// ensureImageBuilderState starts or stops the image builder and waits until it reaches the target state.
+// progress is called with a human-readable message whenever the observed state changes and may be nil.
+func ensureImageBuilderState(
+	ctx context.Context, client metadata.AppStreamAPI, name string, target awstypes.ImageBuilderState, progress func(string),
+) error {
+	var observed awstypes.ImageBuilderState
+
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			out, err := client.DescribeImageBuilders(ctx, &awsappstream.DescribeImageBuildersInput{
+				Names: []string{name},
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(out.ImageBuilders) == 0 {
+				return fmt.Errorf("image builder %q not found", name)
+			}
+
+			imageBuilder := out.ImageBuilders[0]
+			state := imageBuilder.State
+
+			if progress != nil && state != observed && state != target {
+				progress(fmt.Sprintf("image builder %q is %s, waiting for %s", name, state, target))
+			}
+			observed = state
+
+			if state == target {
+				return nil
+			}
+
+			switch state {
+			case awstypes.ImageBuilderStateFailed:
+				// terminal state. only delete is possible
+				return fmt.Errorf("%w: %s", ErrImageBuilderFailed, formatImageBuilderErrors(imageBuilder.ImageBuilderErrors))
+
+			case awstypes.ImageBuilderStateStopped:
+				// startable state
+				_, err = client.StartImageBuilder(ctx, &awsappstream.StartImageBuilderInput{
+					Name: aws.String(name),
+				})
+				if err != nil {
+					return err
+				}
+				// retry as we just started the image builder
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedImageBuilderState, state)
+
+			case awstypes.ImageBuilderStateRunning:
+				// stoppable state
+				_, err = client.StopImageBuilder(ctx, &awsappstream.StopImageBuilderInput{
+					Name: aws.String(name),
+				})
+				if err != nil {
+					return err
+				}
+				// retry as we just stopped the image builder
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedImageBuilderState, state)
+
+			default:
+				// wait for pending, starting or stopping to settle
+				return fmt.Errorf("%w: current=%s", ErrUnexpectedImageBuilderState, state)
+			}
+		},
+		util.WithTimeout(imageBuilderWaitTimeout),
+		util.WithInitBackoff(imageBuilderWaitInitBackoff),
+		util.WithMaxBackoff(imageBuilderWaitMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeImageBuilders.html
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_StartImageBuilder.html
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_StopImageBuilder.html
+		util.WithRetryOnFns(
+			func(err error) bool {
+				return errors.Is(err, ErrUnexpectedImageBuilderState)
+			},
+			util.IsConcurrentModificationException,
+			util.IsOperationNotPermittedException,
+		),
+	)
+}
+
+func formatImageBuilderErrors(imageBuilderErrors []awstypes.ResourceError) string {
+	if len(imageBuilderErrors) == 0 {
+		return "no errors reported by aws"
+	}
+
+	msgs := make([]string, 0, len(imageBuilderErrors))
+	for _, e := range imageBuilderErrors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", e.ErrorCode, aws.ToString(e.ErrorMessage)))
+	}
+	return strings.Join(msgs, "; ")
+}
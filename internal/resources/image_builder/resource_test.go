@@ -120,3 +120,41 @@ func TestAccImageBuilder_noopPlan(t *testing.T) {
 		},
 	})
 }
+
+func testAccImageBuilderDesiredStateConfig(name, desiredState string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_image_builder" "test" {
+  name          = %q
+  instance_type = "stream.standard.small"
+  image_name    = "AppStream-RockyLinux8-11-10-2025"
+
+  desired_state = %q
+}
+`, name, desiredState)
+}
+
+func TestAccImageBuilder_desiredState(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-image-builder-state")
+	resourceName := "awsappstream_image_builder.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageBuilderDesiredStateConfig(name, "RUNNING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+				),
+			},
+			{
+				Config: testAccImageBuilderDesiredStateConfig(name, "STOPPED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+				),
+			},
+		},
+	})
+}
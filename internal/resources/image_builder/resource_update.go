@@ -5,8 +5,11 @@ package image_builder
 
 import (
 	"context"
+	"fmt"
 
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
 )
 
 func (r *resource) Update(ctx context.Context, req tfresource.UpdateRequest, resp *tfresource.UpdateResponse) {
@@ -31,23 +34,42 @@ func (r *resource) Update(ctx context.Context, req tfresource.UpdateRequest, res
 		return
 	}
 
-	arn := plan.ID.ValueString()
+	name := plan.Name.ValueString()
 
 	// guard against unexpected identity drift
 	if !state.ID.IsNull() && !state.ID.IsUnknown() {
-		if state.ID.ValueString() != arn {
+		if state.ID.ValueString() != plan.ID.ValueString() {
 			resp.Diagnostics.AddError(
 				"Unexpected Update Request",
-				"Image builder identity (ARN) changed during update. This should trigger replacement. Please report this issue.",
+				"Image builder identity changed during update. This should trigger replacement. Please report this issue.",
 			)
 			return
 		}
 	}
 
-	_, tagDiags := r.tags.Apply(ctx, arn, plan.Tags)
-	resp.Diagnostics.Append(tagDiags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if !state.ARN.IsNull() && !state.ARN.IsUnknown() {
+		_, tagDiags := r.tags.Apply(ctx, state.ARN.ValueString(), plan.Tags)
+		resp.Diagnostics.Append(tagDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.DesiredState.IsNull() && !plan.DesiredState.IsUnknown() {
+		desiredState := plan.DesiredState.ValueString()
+
+		err := ensureImageBuilderState(ctx, r.appstreamClient, name, awstypes.ImageBuilderState(desiredState), nil)
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Changing AWS AppStream Image Builder State",
+				fmt.Sprintf("Could not change state of image builder %q to %s: %v", name, desiredState, err),
+			)
+			return
+		}
 	}
 
 	newState, diags := r.readImageBuilder(ctx, plan)
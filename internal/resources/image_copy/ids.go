@@ -0,0 +1,22 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_copy
+
+import (
+	"fmt"
+	"strings"
+)
+
+func buildID(destinationRegion, destinationImageName string) string {
+	return fmt.Sprintf("%s|%s", destinationRegion, destinationImageName)
+}
+
+func parseID(id string) (destinationRegion, destinationImageName string, err error) {
+	parts := strings.SplitN(id, "|", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid image copy ID format")
+	}
+
+	return parts[0], parts[1], nil
+}
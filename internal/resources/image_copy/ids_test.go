@@ -0,0 +1,76 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_copy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildID(t *testing.T) {
+	require.Equal(t, "eu-west-1|image-copy", buildID("eu-west-1", "image-copy"))
+}
+
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantRegion string
+		wantImage  string
+		wantErr    bool
+	}{
+		{
+			name:       "valid_id",
+			id:         "eu-west-1|image-copy",
+			wantRegion: "eu-west-1",
+			wantImage:  "image-copy",
+			wantErr:    false,
+		},
+		{
+			name:    "missing_separator",
+			id:      "eu-west-1-image-copy",
+			wantErr: true,
+		},
+		{
+			name:    "empty_string",
+			id:      "",
+			wantErr: true,
+		},
+		{
+			name:    "empty_region",
+			id:      "|image-copy",
+			wantErr: true,
+		},
+		{
+			name:    "empty_image_name",
+			id:      "eu-west-1|",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			region, image, err := parseID(tt.id)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if region != tt.wantRegion || image != tt.wantImage {
+				t.Fatalf(
+					"parseID(%q) = (%q, %q), want (%q, %q)",
+					tt.id, region, image, tt.wantRegion, tt.wantImage,
+				)
+			}
+		})
+	}
+}
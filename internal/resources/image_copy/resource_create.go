@@ -0,0 +1,106 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_copy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/image"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	var plan resourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	sourceName := plan.SourceImageName.ValueString()
+	region := plan.DestinationRegion.ValueString()
+	name := plan.DestinationImageName.ValueString()
+
+	input := &awsappstream.CopyImageInput{
+		SourceImageName:             aws.String(sourceName),
+		DestinationRegion:           aws.String(region),
+		DestinationImageName:        aws.String(name),
+		DestinationImageDescription: util.StringPointerOrNil(plan.DestinationImageDescription),
+	}
+
+	err := util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			_, err := r.appstreamClient.CopyImage(ctx, input)
+			return err
+		},
+		util.WithTimeout(createRetryTimeout),
+		util.WithInitBackoff(createRetryInitBackoff),
+		util.WithMaxBackoff(createRetryMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_CopyImage.html
+		util.WithRetryOnFns(
+			util.IsResourceNotAvailableException,
+		),
+	)
+
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		if util.IsResourceAlreadyExists(err) {
+			resp.Diagnostics.AddError(
+				"AWS AppStream Image Already Exists",
+				fmt.Sprintf(
+					"An image named %q already exists in region %q. To manage it with Terraform, import it using:\n\n"+
+						"  terraform import <resource_address> %q",
+					name, region, buildID(region, name),
+				),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating AWS AppStream Image Copy",
+			fmt.Sprintf("Could not copy image %q to %q in region %q: %v", sourceName, name, region, err),
+		)
+		return
+	}
+
+	err = image.WaitImageAvailable(ctx, r.appstreamForRegion(region), name)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Waiting for AWS AppStream Image Copy",
+			fmt.Sprintf("Image %q in region %q did not become available: %v", name, region, err),
+		)
+		// keep the copy in state so terraform taints it and deletes it on the next apply
+	}
+
+	newState, diags := r.readImageCopy(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_copy
+
+import (
+	"context"
+	"fmt"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/image"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	var state resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	if state.DestinationRegion.IsNull() || state.DestinationImageName.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform State",
+			"Cannot delete image copy because destination_region and destination_image_name must be known.",
+		)
+		return
+	}
+
+	region := state.DestinationRegion.ValueString()
+	name := state.DestinationImageName.ValueString()
+
+	err := image.DeleteImage(ctx, r.appstreamForRegion(region), name)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting AWS AppStream Image Copy",
+			fmt.Sprintf("Could not delete image %q in region %q: %v", name, region, err),
+		)
+		return
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_copy
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type resourceModel struct {
+	// ID is a synthetic identifier composed of "<destination_region>|<destination_image_name>".
+	ID types.String `tfsdk:"id"`
+	// SourceImageName is the name of the image to copy from the configured region (required).
+	SourceImageName types.String `tfsdk:"source_image_name"`
+	// DestinationRegion is the region the image is copied to (required).
+	DestinationRegion types.String `tfsdk:"destination_region"`
+	// DestinationImageName is the name of the copied image (required).
+	DestinationImageName types.String `tfsdk:"destination_image_name"`
+	// DestinationImageDescription is the description of the copied image (optional, computed).
+	DestinationImageDescription types.String `tfsdk:"destination_image_description"`
+	// ARN is the ARN of the copied image (computed).
+	ARN types.String `tfsdk:"arn"`
+	// Platform is the operating system platform of the copied image (computed).
+	Platform types.String `tfsdk:"platform"`
+	// State is the current lifecycle state of the copied image (computed).
+	State types.String `tfsdk:"state"`
+	// CreatedTime is the timestamp when the copied image was created (computed).
+	CreatedTime types.String `tfsdk:"created_time"`
+	// ImageErrors is the list of errors reported by AWS for the copied image (computed).
+	ImageErrors types.Set `tfsdk:"image_errors"`
+}
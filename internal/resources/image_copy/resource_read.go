@@ -0,0 +1,89 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_copy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/image"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Read(ctx context.Context, req tfresource.ReadRequest, resp *tfresource.ReadResponse) {
+	var state resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	newState, diags := r.readImageCopy(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *resource) readImageCopy(ctx context.Context, prior resourceModel) (*resourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	region := prior.DestinationRegion.ValueString()
+	name := prior.DestinationImageName.ValueString()
+
+	img, err := image.DescribeImage(ctx, r.appstreamForRegion(region), name)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return nil, diags
+		}
+
+		diags.AddError(
+			"Error Reading AWS AppStream Image Copy",
+			fmt.Sprintf("Could not read image %q in region %q: %v", name, region, err),
+		)
+		return nil, diags
+	}
+
+	if img == nil {
+		return nil, diags
+	}
+
+	// aws does not report the source of a copy. keep the configured value
+	state := &resourceModel{
+		ID:                          types.StringValue(buildID(region, name)),
+		SourceImageName:             prior.SourceImageName,
+		DestinationRegion:           types.StringValue(region),
+		DestinationImageName:        types.StringValue(aws.ToString(img.Name)),
+		DestinationImageDescription: util.StringOrNull(img.Description),
+		ARN:                         util.StringOrNull(img.Arn),
+		Platform:                    types.StringValue(string(img.Platform)),
+		State:                       types.StringValue(string(img.State)),
+		CreatedTime:                 util.StringFromTime(img.CreatedTime),
+		ImageErrors:                 image.FlattenImageErrors(ctx, img.ImageErrors, &diags),
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return state, diags
+}
@@ -0,0 +1,154 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_copy
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a copy of an AWS AppStream image in another region",
+		MarkdownDescription: "Copies a private AppStream image of the configured region to a destination region " +
+			"and waits until the copy is available. Destroying the resource deletes the copy; the source image is not modified.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the AppStream image copy.",
+				MarkdownDescription: "A synthetic identifier for the image copy, composed of the destination region and destination image name. " +
+					"This value is managed by the provider and cannot be set manually.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_image_name": schema.StringAttribute{
+				Description: "Name of the image to copy.",
+				MarkdownDescription: "The name of the private image in the configured region to copy. " +
+					"Changing this value forces a new copy to be created.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					// aws does not report the source of a copy, so it is unknown after an import
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the source image forces a new copy unless the source is unknown after an import.",
+						"Changing the source image forces a new copy unless the source is unknown after an import.",
+					),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"destination_region": schema.StringAttribute{
+				Description: "Region to copy the image to.",
+				MarkdownDescription: "The AWS region to copy the image to, for example `us-west-2`. " +
+					"May be the configured region. Changing this value forces a new copy to be created.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d+$`),
+						"must be an AWS region name such as us-west-2",
+					),
+				},
+			},
+			"destination_image_name": schema.StringAttribute{
+				Description: "Name of the copied image.",
+				MarkdownDescription: "The name of the image in the destination region. " +
+					"Changing this value forces a new copy to be created.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"destination_image_description": schema.StringAttribute{
+				Description: "Description of the copied image.",
+				MarkdownDescription: "The description of the image in the destination region. " +
+					"If not set, the value reported by AWS is used. Changing this value forces a new copy to be created.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			"arn": schema.StringAttribute{
+				Description:         "ARN of the copied image.",
+				MarkdownDescription: "The Amazon Resource Name (ARN) of the image in the destination region.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"platform": schema.StringAttribute{
+				Description:         "Image platform.",
+				MarkdownDescription: "The operating system platform of the copied image.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description:         "State of the copied image.",
+				MarkdownDescription: "The state of the copied image, for example `AVAILABLE` or `FAILED`.",
+				Computed:            true,
+			},
+			"created_time": schema.StringAttribute{
+				Description:         "Time the copied image was created.",
+				MarkdownDescription: "The timestamp when the copied image was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_errors": schema.SetNestedAttribute{
+				Description:         "Image errors.",
+				MarkdownDescription: "Errors reported by AWS while copying the image.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"error_code": schema.StringAttribute{
+							Description:         "Error code.",
+							MarkdownDescription: "The error code reported by AWS.",
+							Computed:            true,
+						},
+						"error_message": schema.StringAttribute{
+							Description:         "Error message.",
+							MarkdownDescription: "The human-readable error message.",
+							Computed:            true,
+						},
+						"error_timestamp": schema.StringAttribute{
+							Description:         "Error timestamp.",
+							MarkdownDescription: "The time the error occurred, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_copy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+const testAccDestinationRegion = "us-west-2"
+
+func testAccImageCopyConfig(sourceImageName, name string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_image_copy" "test" {
+  source_image_name             = %q
+  destination_region            = %q
+  destination_image_name        = %q
+  destination_image_description = "copied by terraform acceptance tests"
+}
+`, sourceImageName, testAccDestinationRegion, name)
+}
+
+func TestAccImageCopy_basic(t *testing.T) {
+	sourceImageName := testhelpers.TestAccPrivateImageName(t)
+	name := acctest.RandomWithPrefix("tf-acc-image-copy")
+	resourceName := "awsappstream_image_copy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageCopyConfig(sourceImageName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", testAccDestinationRegion+"|"+name),
+					resource.TestCheckResourceAttr(resourceName, "source_image_name", sourceImageName),
+					resource.TestCheckResourceAttr(resourceName, "destination_region", testAccDestinationRegion),
+					resource.TestCheckResourceAttr(resourceName, "destination_image_name", name),
+					resource.TestCheckResourceAttr(resourceName, "destination_image_description", "copied by terraform acceptance tests"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "platform"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// aws does not report the source of a copy
+				ImportStateVerifyIgnore: []string{"source_image_name"},
+			},
+		},
+	})
+}
@@ -0,0 +1,12 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_copy
+
+import "time"
+
+const (
+	createRetryTimeout     = 5 * time.Minute
+	createRetryInitBackoff = 5 * time.Second
+	createRetryMaxBackoff  = 30 * time.Second
+)
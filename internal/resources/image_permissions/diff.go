@@ -0,0 +1,33 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import "sort"
+
+// diffSharedAccounts returns the accounts to stop sharing with and the accounts whose
+// permissions must be granted or changed to get from current to desired.
+func diffSharedAccounts(
+	current map[string]permissions, desired map[string]permissions,
+) (removeAccounts []string, addOrUpdate map[string]permissions) {
+
+	addOrUpdate = make(map[string]permissions)
+
+	for accountID, perms := range current {
+		if desiredPerms, ok := desired[accountID]; !ok {
+			removeAccounts = append(removeAccounts, accountID)
+		} else if desiredPerms != perms {
+			addOrUpdate[accountID] = desiredPerms
+		}
+	}
+
+	for accountID, perms := range desired {
+		if _, ok := current[accountID]; !ok {
+			addOrUpdate[accountID] = perms
+		}
+	}
+
+	sort.Strings(removeAccounts)
+
+	return removeAccounts, addOrUpdate
+}
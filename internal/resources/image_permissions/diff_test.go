@@ -0,0 +1,68 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffSharedAccounts(t *testing.T) {
+	fleetOnly := permissions{AllowFleet: true}
+	builderOnly := permissions{AllowImageBuilder: true}
+	both := permissions{AllowFleet: true, AllowImageBuilder: true}
+
+	tests := []struct {
+		name            string
+		current         map[string]permissions
+		desired         map[string]permissions
+		wantRemove      []string
+		wantAddOrUpdate map[string]permissions
+	}{
+		{
+			name:            "nothing_shared",
+			current:         map[string]permissions{},
+			desired:         map[string]permissions{},
+			wantAddOrUpdate: map[string]permissions{},
+		},
+		{
+			name:    "add_accounts",
+			current: map[string]permissions{},
+			desired: map[string]permissions{"111111111111": fleetOnly, "222222222222": both},
+			wantAddOrUpdate: map[string]permissions{
+				"111111111111": fleetOnly,
+				"222222222222": both,
+			},
+		},
+		{
+			name:            "remove_accounts_sorted",
+			current:         map[string]permissions{"333333333333": both, "111111111111": fleetOnly},
+			desired:         map[string]permissions{},
+			wantRemove:      []string{"111111111111", "333333333333"},
+			wantAddOrUpdate: map[string]permissions{},
+		},
+		{
+			name:            "update_changed_permissions",
+			current:         map[string]permissions{"111111111111": fleetOnly, "222222222222": both},
+			desired:         map[string]permissions{"111111111111": builderOnly, "222222222222": both},
+			wantAddOrUpdate: map[string]permissions{"111111111111": builderOnly},
+		},
+		{
+			name:            "mixed",
+			current:         map[string]permissions{"111111111111": fleetOnly, "222222222222": both},
+			desired:         map[string]permissions{"222222222222": fleetOnly, "333333333333": builderOnly},
+			wantRemove:      []string{"111111111111"},
+			wantAddOrUpdate: map[string]permissions{"222222222222": fleetOnly, "333333333333": builderOnly},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remove, addOrUpdate := diffSharedAccounts(tt.current, tt.desired)
+			require.Equal(t, tt.wantRemove, remove)
+			require.Equal(t, tt.wantAddOrUpdate, addOrUpdate)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// permissions is the access an account has to the shared image.
+type permissions struct {
+	AllowFleet        bool
+	AllowImageBuilder bool
+}
+
+func expandSharedAccounts(ctx context.Context, set types.Set, diags *diag.Diagnostics) map[string]permissions {
+	var models []sharedAccountModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	out := make(map[string]permissions, len(models))
+	for _, m := range models {
+		out[m.AccountID.ValueString()] = permissions{
+			AllowFleet:        m.AllowFleet.ValueBool(),
+			AllowImageBuilder: m.AllowImageBuilder.ValueBool(),
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,55 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var sharedAccountObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"account_id":          types.StringType,
+		"allow_fleet":         types.BoolType,
+		"allow_image_builder": types.BoolType,
+	},
+}
+
+func flattenSharedAccounts(
+	ctx context.Context, awsPermissions []awstypes.SharedImagePermissions, diags *diag.Diagnostics,
+) types.Set {
+
+	// shared_accounts is required. an image that is not shared is an empty set, not null
+	out := make([]sharedAccountModel, 0, len(awsPermissions))
+	for _, p := range awsPermissions {
+		if p.SharedAccountId == nil {
+			continue
+		}
+
+		allowFleet, allowImageBuilder := false, false
+		if p.ImagePermissions != nil {
+			allowFleet = aws.ToBool(p.ImagePermissions.AllowFleet)
+			allowImageBuilder = aws.ToBool(p.ImagePermissions.AllowImageBuilder)
+		}
+
+		out = append(out, sharedAccountModel{
+			AccountID:         types.StringValue(aws.ToString(p.SharedAccountId)),
+			AllowFleet:        types.BoolValue(allowFleet),
+			AllowImageBuilder: types.BoolValue(allowImageBuilder),
+		})
+	}
+
+	setVal, d := types.SetValueFrom(ctx, sharedAccountObjectType, out)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.SetNull(sharedAccountObjectType)
+	}
+
+	return setVal
+}
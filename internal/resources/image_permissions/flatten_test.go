@@ -0,0 +1,53 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenSharedAccounts(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty_is_not_null", func(t *testing.T) {
+		var diags diag.Diagnostics
+		got := flattenSharedAccounts(ctx, nil, &diags)
+		require.False(t, diags.HasError())
+		require.False(t, got.IsNull())
+		require.Empty(t, got.Elements())
+	})
+
+	t.Run("round_trip", func(t *testing.T) {
+		var diags diag.Diagnostics
+		got := flattenSharedAccounts(ctx, []awstypes.SharedImagePermissions{
+			{
+				SharedAccountId: aws.String("111111111111"),
+				ImagePermissions: &awstypes.ImagePermissions{
+					AllowFleet:        aws.Bool(true),
+					AllowImageBuilder: aws.Bool(false),
+				},
+			},
+			{
+				SharedAccountId: aws.String("222222222222"),
+			},
+			{
+				ImagePermissions: &awstypes.ImagePermissions{AllowFleet: aws.Bool(true)},
+			},
+		}, &diags)
+		require.False(t, diags.HasError())
+
+		expanded := expandSharedAccounts(ctx, got, &diags)
+		require.False(t, diags.HasError())
+		require.Equal(t, map[string]permissions{
+			"111111111111": {AllowFleet: true},
+			"222222222222": {},
+		}, expanded)
+	})
+}
@@ -0,0 +1,24 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type model struct {
+	// ID is a synthetic identifier composed of "<name>".
+	ID types.String `tfsdk:"id"`
+	// Name is the name of the private AppStream image to share (required).
+	Name types.String `tfsdk:"name"`
+	// SharedAccounts is the complete set of AWS accounts the image is shared with (required).
+	SharedAccounts types.Set `tfsdk:"shared_accounts"`
+}
+
+type sharedAccountModel struct {
+	// AccountID is the 12-digit identifier of the AWS account the image is shared with (required).
+	AccountID types.String `tfsdk:"account_id"`
+	// AllowFleet indicates whether the account can use the image for fleets (required).
+	AllowFleet types.Bool `tfsdk:"allow_fleet"`
+	// AllowImageBuilder indicates whether the account can use the image for image builders (required).
+	AllowImageBuilder types.Bool `tfsdk:"allow_image_builder"`
+}
@@ -0,0 +1,102 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+// reconcileImagePermissions makes the accounts the image is shared with match desired.
+// The remote permissions are read first, so accounts shared outside of terraform are removed as well.
+func (r *resource) reconcileImagePermissions(ctx context.Context, name string, desired map[string]permissions) error {
+	sharedPermissions, err := r.describeImagePermissions(ctx, name)
+	if err != nil {
+		return fmt.Errorf("describe image permissions: %w", err)
+	}
+
+	removeAccounts, addOrUpdate := diffSharedAccounts(currentPermissions(sharedPermissions), desired)
+
+	for _, accountID := range removeAccounts {
+		if err := r.deleteImagePermissions(ctx, name, accountID); err != nil {
+			return err
+		}
+	}
+
+	accountIDs := make([]string, 0, len(addOrUpdate))
+	for accountID := range addOrUpdate {
+		accountIDs = append(accountIDs, accountID)
+	}
+	sort.Strings(accountIDs)
+
+	for _, accountID := range accountIDs {
+		if err := r.updateImagePermissions(ctx, name, accountID, addOrUpdate[accountID]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *resource) updateImagePermissions(ctx context.Context, name, accountID string, perms permissions) error {
+	err := util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			_, err := r.appstreamClient.UpdateImagePermissions(ctx, &awsappstream.UpdateImagePermissionsInput{
+				Name:            aws.String(name),
+				SharedAccountId: aws.String(accountID),
+				ImagePermissions: &awstypes.ImagePermissions{
+					AllowFleet:        aws.Bool(perms.AllowFleet),
+					AllowImageBuilder: aws.Bool(perms.AllowImageBuilder),
+				},
+			})
+			return err
+		},
+		util.WithTimeout(updateRetryTimeout),
+		util.WithInitBackoff(updateRetryInitBackoff),
+		util.WithMaxBackoff(updateRetryMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_UpdateImagePermissions.html
+		util.WithRetryOnFns(
+			util.IsConcurrentModificationException,
+			util.IsResourceNotAvailableException,
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("share image with account %q: %w", accountID, err)
+	}
+
+	return nil
+}
+
+func (r *resource) deleteImagePermissions(ctx context.Context, name, accountID string) error {
+	err := util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			_, err := r.appstreamClient.DeleteImagePermissions(ctx, &awsappstream.DeleteImagePermissionsInput{
+				Name:            aws.String(name),
+				SharedAccountId: aws.String(accountID),
+			})
+			return err
+		},
+		util.WithTimeout(updateRetryTimeout),
+		util.WithInitBackoff(updateRetryInitBackoff),
+		util.WithMaxBackoff(updateRetryMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DeleteImagePermissions.html
+		util.WithRetryOnFns(
+			util.IsConcurrentModificationException,
+			util.IsResourceNotAvailableException,
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("stop sharing image with account %q: %w", accountID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+)
+
+var (
+	_ tfresource.Resource                   = &resource{}
+	_ tfresource.ResourceWithConfigure      = &resource{}
+	_ tfresource.ResourceWithValidateConfig = &resource{}
+	_ tfresource.ResourceWithImportState    = &resource{}
+)
+
+var AppStreamMaxResults int32 = 500
+
+func NewResource() tfresource.Resource {
+	return &resource{}
+}
+
+type resource struct {
+	appstreamClient metadata.AppStreamAPI
+}
+
+func (r *resource) ValidateConfig(ctx context.Context, req tfresource.ValidateConfigRequest, resp *tfresource.ValidateConfigResponse) {
+	var config model
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SharedAccounts.IsNull() || config.SharedAccounts.IsUnknown() {
+		return
+	}
+
+	var accounts []sharedAccountModel
+	resp.Diagnostics.Append(config.SharedAccounts.ElementsAs(ctx, &accounts, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the set only removes exact duplicates. one account with two permission sets is ambiguous
+	seen := make(map[string]struct{}, len(accounts))
+	for _, account := range accounts {
+		if account.AccountID.IsNull() || account.AccountID.IsUnknown() {
+			continue
+		}
+
+		accountID := account.AccountID.ValueString()
+		if _, ok := seen[accountID]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("shared_accounts"),
+				"Duplicate Shared Account",
+				fmt.Sprintf("Account %q is listed more than once in `shared_accounts`.", accountID),
+			)
+			continue
+		}
+		seen[accountID] = struct{}{}
+	}
+}
+
+func (r *resource) Metadata(_ context.Context, req tfresource.MetadataRequest, resp *tfresource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_permissions"
+}
+
+func (r *resource) Configure(_ context.Context, req tfresource.ConfigureRequest, resp *tfresource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*metadata.Metadata)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Metadata, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if meta.Appstream == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *Metadata.Appstream, got: nil. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.appstreamClient = meta.Appstream
+}
+
+func (r *resource) ImportState(ctx context.Context, req tfresource.ImportStateRequest, resp *tfresource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier format: <image_name>",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
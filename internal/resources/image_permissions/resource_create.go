@@ -0,0 +1,62 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import (
+	"context"
+	"fmt"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	var plan model
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	desired := expandSharedAccounts(ctx, plan.SharedAccounts, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcileImagePermissions(ctx, name, desired); err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating AWS AppStream Image Permissions",
+			fmt.Sprintf("Could not share image %q: %v", name, err),
+		)
+		return
+	}
+
+	newState, diags := r.readImagePermissions(ctx, name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Creating AWS AppStream Image Permissions",
+			fmt.Sprintf("Image %q was not found after updating its permissions.", name),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
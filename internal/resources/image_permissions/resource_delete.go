@@ -0,0 +1,45 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import (
+	"context"
+	"fmt"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	var state model
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	name := state.Name.ValueString()
+
+	// the resource is authoritative, so destroying it stops sharing the image with every account
+	if err := r.reconcileImagePermissions(ctx, name, map[string]permissions{}); err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		// if the image is already gone, that's fine for delete.
+		if util.IsAppStreamNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting AWS AppStream Image Permissions",
+			fmt.Sprintf("Could not stop sharing image %q: %v", name, err),
+		)
+		return
+	}
+}
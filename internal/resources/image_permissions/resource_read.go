@@ -0,0 +1,127 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Read(ctx context.Context, req tfresource.ReadRequest, resp *tfresource.ReadResponse) {
+
+	var state model
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	newState, diags := r.readImagePermissions(ctx, state.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *resource) readImagePermissions(ctx context.Context, name string) (*model, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sharedPermissions, err := r.describeImagePermissions(ctx, name)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return nil, diags
+		}
+
+		if util.IsAppStreamNotFound(err) {
+			return nil, diags
+		}
+
+		diags.AddError(
+			"Error Reading AWS AppStream Image Permissions",
+			fmt.Sprintf("Could not read permissions of image %q: %v", name, err),
+		)
+		return nil, diags
+	}
+
+	state := &model{
+		ID:             types.StringValue(name),
+		Name:           types.StringValue(name),
+		SharedAccounts: flattenSharedAccounts(ctx, sharedPermissions, &diags),
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return state, diags
+}
+
+// describeImagePermissions returns the permissions of every account the image is shared with.
+func (r *resource) describeImagePermissions(
+	ctx context.Context, name string,
+) ([]awstypes.SharedImagePermissions, error) {
+
+	var sharedPermissions []awstypes.SharedImagePermissions
+	var nextToken *string
+
+	for {
+		out, err := r.appstreamClient.DescribeImagePermissions(ctx, &awsappstream.DescribeImagePermissionsInput{
+			Name:       aws.String(name),
+			NextToken:  nextToken,
+			MaxResults: aws.Int32(AppStreamMaxResults),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sharedPermissions = append(sharedPermissions, out.SharedImagePermissionsList...)
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	return sharedPermissions, nil
+}
+
+// currentPermissions returns the remote permissions keyed by account ID.
+func currentPermissions(sharedPermissions []awstypes.SharedImagePermissions) map[string]permissions {
+	out := make(map[string]permissions, len(sharedPermissions))
+	for _, p := range sharedPermissions {
+		if p.SharedAccountId == nil {
+			continue
+		}
+
+		var perms permissions
+		if p.ImagePermissions != nil {
+			perms.AllowFleet = aws.ToBool(p.ImagePermissions.AllowFleet)
+			perms.AllowImageBuilder = aws.ToBool(p.ImagePermissions.AllowImageBuilder)
+		}
+		out[aws.ToString(p.SharedAccountId)] = perms
+	}
+
+	return out
+}
@@ -0,0 +1,82 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the sharing of an AWS AppStream Image with other AWS accounts",
+		MarkdownDescription: "Manages the AWS accounts a private AppStream image is shared with. " +
+			"This resource is authoritative: accounts that are not listed in `shared_accounts` lose access to the image, " +
+			"and destroying the resource stops sharing the image with every account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the AppStream image permissions.",
+				MarkdownDescription: "A synthetic identifier for the image permissions, equal to the image name. " +
+					"This value is managed by the provider and cannot be set manually.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the AppStream image.",
+				MarkdownDescription: "The name of the private AppStream image to share. " +
+					"Changing this value forces the image permissions to be replaced.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"shared_accounts": schema.SetNestedAttribute{
+				Description: "AWS accounts the image is shared with.",
+				MarkdownDescription: "The complete set of AWS accounts the image is shared with, " +
+					"together with the permissions granted to each account.",
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							Description:         "AWS account ID.",
+							MarkdownDescription: "The 12-digit identifier of the AWS account the image is shared with.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(
+									regexp.MustCompile(`^\d{12}$`),
+									"must be a 12-digit AWS account ID",
+								),
+							},
+						},
+						"allow_fleet": schema.BoolAttribute{
+							Description:         "Allow the image to be used for fleets.",
+							MarkdownDescription: "Whether the account can use the image to launch fleets.",
+							Required:            true,
+						},
+						"allow_image_builder": schema.BoolAttribute{
+							Description:         "Allow the image to be used for image builders.",
+							MarkdownDescription: "Whether the account can use the image to launch image builders.",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccImagePermissionsConfig(imageName, accountID string, allowImageBuilder bool) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_image_permissions" "test" {
+  name = %q
+
+  shared_accounts = [
+    {
+      account_id          = %q
+      allow_fleet         = true
+      allow_image_builder = %t
+    }
+  ]
+}
+`, imageName, accountID, allowImageBuilder)
+}
+
+func TestAccImagePermissions_basic(t *testing.T) {
+	imageName := testhelpers.TestAccPrivateImageName(t)
+	accountID := testhelpers.TestAccSharedAccountID(t)
+	resourceName := "awsappstream_image_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagePermissionsConfig(imageName, accountID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", imageName),
+					resource.TestCheckResourceAttr(resourceName, "id", imageName),
+					resource.TestCheckResourceAttr(resourceName, "shared_accounts.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "shared_accounts.*", map[string]string{
+						"account_id":          accountID,
+						"allow_fleet":         "true",
+						"allow_image_builder": "false",
+					}),
+				),
+			},
+			{
+				Config: testAccImagePermissionsConfig(imageName, accountID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "shared_accounts.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "shared_accounts.*", map[string]string{
+						"account_id":          accountID,
+						"allow_fleet":         "true",
+						"allow_image_builder": "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
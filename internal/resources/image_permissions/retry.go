@@ -0,0 +1,12 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package image_permissions
+
+import "time"
+
+const (
+	updateRetryTimeout     = 5 * time.Minute
+	updateRetryInitBackoff = 2 * time.Second
+	updateRetryMaxBackoff  = 30 * time.Second
+)
@@ -0,0 +1,100 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (a *expireAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config expireActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	stackName := config.StackName.ValueString()
+	fleetName := config.FleetName.ValueString()
+
+	newInput := func() *awsappstream.DescribeSessionsInput {
+		return &awsappstream.DescribeSessionsInput{
+			StackName:          aws.String(stackName),
+			FleetName:          aws.String(fleetName),
+			UserId:             util.StringPointerOrNil(config.UserID),
+			AuthenticationType: awstypes.AuthenticationType(config.AuthenticationType.ValueString()),
+			Limit:              aws.Int32(AppStreamMaxResults),
+		}
+	}
+
+	progress := func(msg string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: msg})
+	}
+
+	sessions, err := describeSessions(ctx, a.appstreamClient, newInput())
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading AWS AppStream Sessions",
+			fmt.Sprintf("Could not read sessions of stack %q and fleet %q: %v", stackName, fleetName, err),
+		)
+		return
+	}
+
+	var sessionIDs []string
+	for _, s := range sessions {
+		if s.State != awstypes.SessionStateExpired {
+			sessionIDs = append(sessionIDs, aws.ToString(s.Id))
+		}
+	}
+
+	if len(sessionIDs) == 0 {
+		progress(fmt.Sprintf("no sessions to expire on stack %q and fleet %q", stackName, fleetName))
+		return
+	}
+
+	progress(fmt.Sprintf("expiring %d sessions on stack %q and fleet %q", len(sessionIDs), stackName, fleetName))
+
+	for _, id := range sessionIDs {
+		if err := expireSession(ctx, a.appstreamClient, id); err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Expiring AWS AppStream Session",
+				fmt.Sprintf("Could not expire session %q of stack %q and fleet %q: %v", id, stackName, fleetName, err),
+			)
+			return
+		}
+	}
+
+	if err := waitSessionsExpired(ctx, a.appstreamClient, newInput, sessionIDs, progress); err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Expiring AWS AppStream Sessions",
+			fmt.Sprintf("Sessions of stack %q and fleet %q did not expire: %v", stackName, fleetName, err),
+		)
+		return
+	}
+
+	progress(fmt.Sprintf("expired %d sessions on stack %q and fleet %q", len(sessionIDs), stackName, fleetName))
+}
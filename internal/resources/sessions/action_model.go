@@ -0,0 +1,13 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type expireActionModel struct {
+	StackName          types.String `tfsdk:"stack_name"`
+	FleetName          types.String `tfsdk:"fleet_name"`
+	UserID             types.String `tfsdk:"user_id"`
+	AuthenticationType types.String `tfsdk:"authentication_type"`
+}
@@ -0,0 +1,72 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (a *expireAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Expire the streaming sessions of an AWS AppStream Stack and Fleet",
+		MarkdownDescription: "Expires the streaming sessions of an AppStream stack and fleet and waits until they are gone. " +
+			"Users are disconnected immediately, so this action is typically invoked before a disruptive change, " +
+			"such as stopping a fleet. Nothing is written to the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"stack_name": schema.StringAttribute{
+				Description:         "Name of the AppStream stack.",
+				MarkdownDescription: "The name of the AppStream stack the sessions belong to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"fleet_name": schema.StringAttribute{
+				Description:         "Name of the AppStream fleet.",
+				MarkdownDescription: "The name of the AppStream fleet the sessions belong to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Identifier of the user.",
+				MarkdownDescription: "Only expire the sessions of this user. " +
+					"Requires `authentication_type` to be set.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 128),
+					stringvalidator.AlsoRequires(path.MatchRoot("authentication_type")),
+				},
+			},
+			"authentication_type": schema.StringAttribute{
+				Description: "Authentication type of the users.",
+				MarkdownDescription: "Only expire the sessions of users authenticated this way. " +
+					"Valid values are `API`, `SAML`, `USERPOOL` and `AWS_AD`. AWS defaults to `API`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"API",
+						"SAML",
+						"USERPOOL",
+						"AWS_AD",
+					),
+				},
+			},
+		},
+	}
+}
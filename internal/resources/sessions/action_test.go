@@ -0,0 +1,53 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccExpireSessionsActionConfig(stackName, fleetName string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+action "awsappstream_expire_sessions" "test" {
+  config {
+    stack_name          = %q
+    fleet_name          = %q
+    user_id             = "tf-acc-nobody"
+    authentication_type = "API"
+  }
+}
+
+resource "terraform_data" "test" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.awsappstream_expire_sessions.test]
+    }
+  }
+}
+`, stackName, fleetName)
+}
+
+// filters by a user that never streams, so no real sessions are expired
+func TestAccExpireSessionsAction_basic(t *testing.T) {
+	stackName, fleetName := testhelpers.TestAccStreamingStackFleet(t)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExpireSessionsActionConfig(stackName, fleetName),
+			},
+		},
+	})
+}
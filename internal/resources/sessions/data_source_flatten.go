@@ -0,0 +1,99 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var networkAccessConfigurationObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"eni_id":                 types.StringType,
+		"eni_private_ip_address": types.StringType,
+		"eni_ipv6_addresses":     types.SetType{ElemType: types.StringType},
+	},
+}
+
+var sessionObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                           types.StringType,
+		"user_id":                      types.StringType,
+		"authentication_type":          types.StringType,
+		"state":                        types.StringType,
+		"connection_state":             types.StringType,
+		"instance_id":                  types.StringType,
+		"start_time":                   types.StringType,
+		"max_expiration_time":          types.StringType,
+		"network_access_configuration": networkAccessConfigurationObjectType,
+	},
+}
+
+func flattenSessions(ctx context.Context, awsSessions []awstypes.Session, diags *diag.Diagnostics) types.List {
+	// sort for a stable order. AWS returns the sessions in no particular order
+	sorted := make([]awstypes.Session, len(awsSessions))
+	copy(sorted, awsSessions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return aws.ToString(sorted[i].Id) < aws.ToString(sorted[j].Id)
+	})
+
+	// no sessions is an empty list, not null
+	out := make([]sessionModel, 0, len(sorted))
+	for _, s := range sorted {
+		out = append(out, sessionModel{
+			ID:                         util.StringOrNull(s.Id),
+			UserID:                     util.StringOrNull(s.UserId),
+			AuthenticationType:         enumStringOrNull(s.AuthenticationType),
+			State:                      enumStringOrNull(s.State),
+			ConnectionState:            enumStringOrNull(s.ConnectionState),
+			InstanceID:                 util.StringOrNull(s.InstanceId),
+			StartTime:                  util.StringFromTime(s.StartTime),
+			MaxExpirationTime:          util.StringFromTime(s.MaxExpirationTime),
+			NetworkAccessConfiguration: flattenNetworkAccessConfiguration(ctx, s.NetworkAccessConfiguration, diags),
+		})
+	}
+
+	listVal, d := types.ListValueFrom(ctx, sessionObjectType, out)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ListNull(sessionObjectType)
+	}
+
+	return listVal
+}
+
+func flattenNetworkAccessConfiguration(
+	ctx context.Context, nac *awstypes.NetworkAccessConfiguration, diags *diag.Diagnostics,
+) types.Object {
+
+	if nac == nil {
+		return types.ObjectNull(networkAccessConfigurationObjectType.AttrTypes)
+	}
+
+	objVal, d := types.ObjectValueFrom(ctx, networkAccessConfigurationObjectType.AttrTypes, networkAccessConfigurationModel{
+		EniID:               util.StringOrNull(nac.EniId),
+		EniPrivateIPAddress: util.StringOrNull(nac.EniPrivateIpAddress),
+		EniIPv6Addresses:    util.SetStringOrNull(ctx, nac.EniIpv6Addresses, diags),
+	})
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ObjectNull(networkAccessConfigurationObjectType.AttrTypes)
+	}
+
+	return objVal
+}
+
+func enumStringOrNull[T ~string](v T) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(string(v))
+}
@@ -0,0 +1,81 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenSessions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty_is_not_null", func(t *testing.T) {
+		var diags diag.Diagnostics
+		got := flattenSessions(ctx, nil, &diags)
+		require.False(t, diags.HasError())
+		require.False(t, got.IsNull())
+		require.Empty(t, got.Elements())
+	})
+
+	t.Run("sorted_by_id", func(t *testing.T) {
+		started := time.Date(2024, 6, 17, 8, 0, 0, 0, time.UTC)
+		expires := started.Add(8 * time.Hour)
+
+		var diags diag.Diagnostics
+		got := flattenSessions(ctx, []awstypes.Session{
+			{
+				Id:                 aws.String("session-b"),
+				UserId:             aws.String("jane"),
+				AuthenticationType: awstypes.AuthenticationTypeSaml,
+				State:              awstypes.SessionStateActive,
+				ConnectionState:    awstypes.SessionConnectionStateConnected,
+				InstanceId:         aws.String("i-0123456789abcdef0"),
+				StartTime:          &started,
+				MaxExpirationTime:  &expires,
+				NetworkAccessConfiguration: &awstypes.NetworkAccessConfiguration{
+					EniId:               aws.String("eni-0123456789abcdef0"),
+					EniPrivateIpAddress: aws.String("10.0.0.10"),
+				},
+			},
+			{
+				Id:     aws.String("session-a"),
+				UserId: aws.String("john"),
+				State:  awstypes.SessionStatePending,
+			},
+		}, &diags)
+		require.False(t, diags.HasError())
+
+		var sessions []sessionModel
+		diags.Append(got.ElementsAs(ctx, &sessions, false)...)
+		require.False(t, diags.HasError())
+		require.Len(t, sessions, 2)
+
+		require.Equal(t, "session-a", sessions[0].ID.ValueString())
+		require.Equal(t, "PENDING", sessions[0].State.ValueString())
+		require.True(t, sessions[0].AuthenticationType.IsNull())
+		require.True(t, sessions[0].ConnectionState.IsNull())
+		require.True(t, sessions[0].NetworkAccessConfiguration.IsNull())
+
+		require.Equal(t, "session-b", sessions[1].ID.ValueString())
+		require.Equal(t, "SAML", sessions[1].AuthenticationType.ValueString())
+		require.Equal(t, "CONNECTED", sessions[1].ConnectionState.ValueString())
+		require.Equal(t, "2024-06-17T08:00:00Z", sessions[1].StartTime.ValueString())
+		require.Equal(t, "2024-06-17T16:00:00Z", sessions[1].MaxExpirationTime.ValueString())
+
+		var nac networkAccessConfigurationModel
+		diags.Append(sessions[1].NetworkAccessConfiguration.As(ctx, &nac, basetypes.ObjectAsOptions{})...)
+		require.False(t, diags.HasError())
+		require.Equal(t, "eni-0123456789abcdef0", nac.EniID.ValueString())
+		require.Equal(t, "10.0.0.10", nac.EniPrivateIPAddress.ValueString())
+		require.True(t, nac.EniIPv6Addresses.IsNull())
+	})
+}
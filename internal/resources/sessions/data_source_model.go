@@ -0,0 +1,51 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type dataSourceModel struct {
+	// ID is a synthetic identifier composed of "<stack_name>|<fleet_name>".
+	ID types.String `tfsdk:"id"`
+	// StackName is the name of the stack the sessions belong to (required).
+	StackName types.String `tfsdk:"stack_name"`
+	// FleetName is the name of the fleet the sessions belong to (required).
+	FleetName types.String `tfsdk:"fleet_name"`
+	// UserID only returns the sessions of this user (optional).
+	UserID types.String `tfsdk:"user_id"`
+	// AuthenticationType only returns the sessions of users authenticated this way (optional).
+	AuthenticationType types.String `tfsdk:"authentication_type"`
+	// Sessions is the list of streaming sessions (computed).
+	Sessions types.List `tfsdk:"sessions"`
+}
+
+type sessionModel struct {
+	// ID is the identifier of the streaming session.
+	ID types.String `tfsdk:"id"`
+	// UserID is the identifier of the user the session was created for.
+	UserID types.String `tfsdk:"user_id"`
+	// AuthenticationType is how the user was authenticated.
+	AuthenticationType types.String `tfsdk:"authentication_type"`
+	// State is the current state of the session.
+	State types.String `tfsdk:"state"`
+	// ConnectionState indicates whether a user is connected to the session.
+	ConnectionState types.String `tfsdk:"connection_state"`
+	// InstanceID is the identifier of the instance hosting the session.
+	InstanceID types.String `tfsdk:"instance_id"`
+	// StartTime is the time a streaming instance was dedicated to the user.
+	StartTime types.String `tfsdk:"start_time"`
+	// MaxExpirationTime is the time the session expires at the latest.
+	MaxExpirationTime types.String `tfsdk:"max_expiration_time"`
+	// NetworkAccessConfiguration is the network details of the session.
+	NetworkAccessConfiguration types.Object `tfsdk:"network_access_configuration"`
+}
+
+type networkAccessConfigurationModel struct {
+	// EniID is the identifier of the network interface of the streaming instance.
+	EniID types.String `tfsdk:"eni_id"`
+	// EniPrivateIPAddress is the private IP address of the network interface.
+	EniPrivateIPAddress types.String `tfsdk:"eni_private_ip_address"`
+	// EniIPv6Addresses is the IPv6 addresses of the network interface.
+	EniIPv6Addresses types.Set `tfsdk:"eni_ipv6_addresses"`
+}
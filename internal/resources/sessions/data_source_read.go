@@ -0,0 +1,78 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (ds *dataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config dataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	if config.StackName.IsNull() || config.StackName.IsUnknown() ||
+		config.FleetName.IsNull() || config.FleetName.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Configuration",
+			"Cannot read sessions because stack_name and fleet_name must be set and known.",
+		)
+		return
+	}
+
+	stackName := config.StackName.ValueString()
+	fleetName := config.FleetName.ValueString()
+
+	input := &awsappstream.DescribeSessionsInput{
+		StackName:          aws.String(stackName),
+		FleetName:          aws.String(fleetName),
+		UserId:             util.StringPointerOrNil(config.UserID),
+		AuthenticationType: awstypes.AuthenticationType(config.AuthenticationType.ValueString()),
+		Limit:              aws.Int32(AppStreamMaxResults),
+	}
+
+	sessions, err := describeSessions(ctx, ds.appstreamClient, input)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		if util.IsAppStreamNotFound(err) {
+			resp.Diagnostics.AddError(
+				"AWS AppStream Stack Or Fleet Not Found",
+				fmt.Sprintf("No stack %q with fleet %q was found.", stackName, fleetName),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading AWS AppStream Sessions",
+			fmt.Sprintf("Could not read sessions of stack %q and fleet %q: %v", stackName, fleetName, err),
+		)
+		return
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%s|%s", stackName, fleetName))
+	config.Sessions = flattenSessions(ctx, sessions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
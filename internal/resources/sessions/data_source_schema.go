@@ -0,0 +1,149 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (ds *dataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Read the streaming sessions of an AWS AppStream Stack and Fleet",
+		MarkdownDescription: "Reads the streaming sessions of an AppStream stack and fleet. " +
+			"This data source can be used to find out who is streaming before a disruptive change is applied.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the AppStream sessions.",
+				MarkdownDescription: "A synthetic identifier composed of the stack name and fleet name.",
+				Computed:            true,
+			},
+			"stack_name": schema.StringAttribute{
+				Description:         "Name of the AppStream stack.",
+				MarkdownDescription: "The name of the AppStream stack the sessions belong to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"fleet_name": schema.StringAttribute{
+				Description:         "Name of the AppStream fleet.",
+				MarkdownDescription: "The name of the AppStream fleet the sessions belong to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Identifier of the user.",
+				MarkdownDescription: "Only return the sessions of this user. " +
+					"Requires `authentication_type` to be set.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 128),
+					stringvalidator.AlsoRequires(path.MatchRoot("authentication_type")),
+				},
+			},
+			"authentication_type": schema.StringAttribute{
+				Description: "Authentication type of the users.",
+				MarkdownDescription: "Only return the sessions of users authenticated this way. " +
+					"Valid values are `API`, `SAML`, `USERPOOL` and `AWS_AD`. AWS defaults to `API`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"API",
+						"SAML",
+						"USERPOOL",
+						"AWS_AD",
+					),
+				},
+			},
+			"sessions": schema.ListNestedAttribute{
+				Description:         "Streaming sessions.",
+				MarkdownDescription: "The streaming sessions of the stack and fleet, sorted by session ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "Identifier of the session.",
+							MarkdownDescription: "The identifier of the streaming session.",
+							Computed:            true,
+						},
+						"user_id": schema.StringAttribute{
+							Description:         "Identifier of the user.",
+							MarkdownDescription: "The identifier of the user the session was created for.",
+							Computed:            true,
+						},
+						"authentication_type": schema.StringAttribute{
+							Description:         "Authentication type of the user.",
+							MarkdownDescription: "How the user was authenticated.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							Description:         "State of the session.",
+							MarkdownDescription: "The current state of the streaming session: `ACTIVE`, `PENDING` or `EXPIRED`.",
+							Computed:            true,
+						},
+						"connection_state": schema.StringAttribute{
+							Description:         "Connection state of the session.",
+							MarkdownDescription: "Whether a user is connected to the streaming session: `CONNECTED` or `NOT_CONNECTED`.",
+							Computed:            true,
+						},
+						"instance_id": schema.StringAttribute{
+							Description:         "Identifier of the instance.",
+							MarkdownDescription: "The identifier of the instance hosting the session.",
+							Computed:            true,
+						},
+						"start_time": schema.StringAttribute{
+							Description:         "Start time of the session.",
+							MarkdownDescription: "The time a streaming instance was dedicated to the user, in RFC3339 format.",
+							Computed:            true,
+						},
+						"max_expiration_time": schema.StringAttribute{
+							Description:         "Maximum expiration time of the session.",
+							MarkdownDescription: "The time the session expires at the latest, in RFC3339 format.",
+							Computed:            true,
+						},
+						"network_access_configuration": schema.SingleNestedAttribute{
+							Description:         "Network details of the session.",
+							MarkdownDescription: "The network details of the streaming session.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"eni_id": schema.StringAttribute{
+									Description:         "Network interface ID.",
+									MarkdownDescription: "The identifier of the network interface of the streaming instance.",
+									Computed:            true,
+								},
+								"eni_private_ip_address": schema.StringAttribute{
+									Description:         "Private IP address.",
+									MarkdownDescription: "The private IP address of the network interface.",
+									Computed:            true,
+								},
+								"eni_ipv6_addresses": schema.SetAttribute{
+									Description:         "IPv6 addresses.",
+									MarkdownDescription: "The IPv6 addresses of the network interface.",
+									Computed:            true,
+									ElementType:         types.StringType,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccSessionsDataSourceConfig(stackName, fleetName string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+data "awsappstream_sessions" "test" {
+  stack_name = %q
+  fleet_name = %q
+}
+
+data "awsappstream_sessions" "filtered" {
+  stack_name          = %[1]q
+  fleet_name          = %[2]q
+  user_id             = "tf-acc-nobody"
+  authentication_type = "API"
+}
+`, stackName, fleetName)
+}
+
+func TestAccSessionsDataSource_basic(t *testing.T) {
+	stackName, fleetName := testhelpers.TestAccStreamingStackFleet(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSessionsDataSourceConfig(stackName, fleetName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.awsappstream_sessions.test", "id", stackName+"|"+fleetName),
+					resource.TestCheckResourceAttrSet("data.awsappstream_sessions.test", "sessions.#"),
+					resource.TestCheckResourceAttr("data.awsappstream_sessions.filtered", "sessions.#", "0"),
+				),
+			},
+		},
+	})
+}
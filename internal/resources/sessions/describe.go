@@ -0,0 +1,34 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+)
+
+// describeSessions pages through DescribeSessions and returns all sessions matching the input.
+// input.NextToken is advanced while paging.
+func describeSessions(
+	ctx context.Context, client metadata.AppStreamAPI, input *awsappstream.DescribeSessionsInput,
+) ([]awstypes.Session, error) {
+	var sessions []awstypes.Session
+
+	for {
+		out, err := client.DescribeSessions(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, out.Sessions...)
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			return sessions, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
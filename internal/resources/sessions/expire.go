@@ -0,0 +1,103 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var ErrSessionsNotExpired = errors.New("sessions not expired yet")
+
+// expireSession expires a single streaming session. Sessions that are already gone are ignored.
+func expireSession(ctx context.Context, client metadata.AppStreamAPI, sessionID string) error {
+	err := util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			_, err := client.ExpireSession(ctx, &awsappstream.ExpireSessionInput{
+				SessionId: aws.String(sessionID),
+			})
+			return err
+		},
+		util.WithTimeout(expireRetryTimeout),
+		util.WithInitBackoff(expireRetryInitBackoff),
+		util.WithMaxBackoff(expireRetryMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_ExpireSession.html
+		util.WithRetryOnFns(
+			util.IsConcurrentModificationException,
+			util.IsOperationNotPermittedException,
+		),
+	)
+	if err != nil && !util.IsAppStreamNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// waitSessionsExpired waits until none of the given sessions is returned by newInput anymore.
+// progress is called whenever the number of remaining sessions changes and may be nil.
+func waitSessionsExpired(
+	ctx context.Context,
+	client metadata.AppStreamAPI,
+	newInput func() *awsappstream.DescribeSessionsInput,
+	sessionIDs []string,
+	progress func(string),
+) error {
+	remaining := len(sessionIDs)
+
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			sessions, err := describeSessions(ctx, client, newInput())
+			if err != nil {
+				return err
+			}
+
+			pending := pendingSessionIDs(sessions, sessionIDs)
+			if len(pending) == 0 {
+				return nil
+			}
+
+			if progress != nil && len(pending) != remaining {
+				progress(fmt.Sprintf("waiting for %d of %d sessions to expire", len(pending), len(sessionIDs)))
+			}
+			remaining = len(pending)
+
+			return fmt.Errorf("%w: %d remaining", ErrSessionsNotExpired, len(pending))
+		},
+		util.WithTimeout(expireWaitTimeout),
+		util.WithInitBackoff(expireWaitInitBackoff),
+		util.WithMaxBackoff(expireWaitMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeSessions.html
+		util.WithRetryOnFns(
+			func(err error) bool {
+				return errors.Is(err, ErrSessionsNotExpired)
+			},
+		),
+	)
+}
+
+// pendingSessionIDs returns the IDs of sessionIDs that are still listed in sessions and not yet expired.
+func pendingSessionIDs(sessions []awstypes.Session, sessionIDs []string) []string {
+	listed := make(map[string]awstypes.SessionState, len(sessions))
+	for _, s := range sessions {
+		listed[aws.ToString(s.Id)] = s.State
+	}
+
+	var pending []string
+	for _, id := range sessionIDs {
+		state, ok := listed[id]
+		if ok && state != awstypes.SessionStateExpired {
+			pending = append(pending, id)
+		}
+	}
+	return pending
+}
@@ -0,0 +1,52 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingSessionIDs(t *testing.T) {
+	tests := []struct {
+		name       string
+		sessions   []awstypes.Session
+		sessionIDs []string
+		want       []string
+	}{
+		{
+			name:       "all_gone",
+			sessions:   nil,
+			sessionIDs: []string{"a", "b"},
+			want:       nil,
+		},
+		{
+			name: "expired_state_is_not_pending",
+			sessions: []awstypes.Session{
+				{Id: aws.String("a"), State: awstypes.SessionStateExpired},
+				{Id: aws.String("b"), State: awstypes.SessionStateActive},
+			},
+			sessionIDs: []string{"a", "b"},
+			want:       []string{"b"},
+		},
+		{
+			name: "new_sessions_are_ignored",
+			sessions: []awstypes.Session{
+				{Id: aws.String("a"), State: awstypes.SessionStateActive},
+				{Id: aws.String("c"), State: awstypes.SessionStatePending},
+			},
+			sessionIDs: []string{"a", "b"},
+			want:       []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, pendingSessionIDs(tt.sessions, tt.sessionIDs))
+		})
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import "time"
+
+const (
+	expireRetryTimeout     = 5 * time.Minute
+	expireRetryInitBackoff = 2 * time.Second
+	expireRetryMaxBackoff  = 30 * time.Second
+
+	expireWaitTimeout     = 15 * time.Minute
+	expireWaitInitBackoff = 10 * time.Second
+	expireWaitMaxBackoff  = 1 * time.Minute
+)
@@ -26,12 +26,16 @@ type model struct {
 	ApplicationSettings types.Object `tfsdk:"application_settings"`
 	// Tags is the resource tags to apply to the stack (optional).
 	Tags types.Map `tfsdk:"tags"`
+	// TagsAll is the map of all tags assigned to the stack, including provider default tags (computed).
+	TagsAll types.Map `tfsdk:"tags_all"`
 	// AccessEndpoints is the list of interface VPC endpoints users of the stack can connect through (optional).
 	AccessEndpoints types.Set `tfsdk:"access_endpoints"`
 	// EmbedHostDomains is the domains where streaming sessions can be embedded in an iframe (optional).
 	EmbedHostDomains types.Set `tfsdk:"embed_host_domains"`
 	// StreamingExperienceSettings is the streaming protocol the stack should prefer (optional).
 	StreamingExperienceSettings types.Object `tfsdk:"streaming_experience_settings"`
+	// ForceDestroy disassociates all fleets from the stack before deletion (optional, computed).
+	ForceDestroy types.Bool `tfsdk:"force_destroy"`
 	// ARN of the AppStream stack (computed).
 	ARN types.String `tfsdk:"arn"`
 	// CreatedTime is the timestamp when the AppStream stack was created (computed).
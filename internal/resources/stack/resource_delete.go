@@ -5,6 +5,7 @@ package stack
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -35,6 +36,21 @@ func (r *resource) Delete(ctx context.Context, req tfresource.DeleteRequest, res
 
 	name := state.Name.ValueString()
 
+	if state.ForceDestroy.ValueBool() {
+		err := r.disassociateFleets(ctx, name)
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Deleting AWS AppStream Stack",
+				fmt.Sprintf("Could not disassociate fleets from stack %q: %v", name, err),
+			)
+			return
+		}
+	}
+
 	_, err := r.appstreamClient.DeleteStack(ctx, &awsappstream.DeleteStackInput{
 		Name: aws.String(name),
 	})
@@ -55,3 +71,76 @@ func (r *resource) Delete(ctx context.Context, req tfresource.DeleteRequest, res
 		return
 	}
 }
+
+var ErrStackStillAssociated = errors.New("stack still associated with fleets")
+
+// disassociateFleets removes every fleet association of the stack, including
+// associations not managed by terraform, and waits until none are left.
+func (r *resource) disassociateFleets(ctx context.Context, name string) error {
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			fleetNames, err := r.listAssociatedFleets(ctx, name)
+			if err != nil {
+				if util.IsAppStreamNotFound(err) {
+					// already deleted
+					return nil
+				}
+				return err
+			}
+
+			if len(fleetNames) == 0 {
+				return nil
+			}
+
+			for _, fleetName := range fleetNames {
+				_, err = r.appstreamClient.DisassociateFleet(ctx, &awsappstream.DisassociateFleetInput{
+					FleetName: aws.String(fleetName),
+					StackName: aws.String(name),
+				})
+				if err != nil && !util.IsAppStreamNotFound(err) {
+					return err
+				}
+			}
+
+			// re-check until the disassociations have settled
+			return fmt.Errorf("%w: fleets=%v", ErrStackStillAssociated, fleetNames)
+		},
+		util.WithTimeout(disassociateRetryTimeout),
+		util.WithInitBackoff(disassociateRetryInitBackoff),
+		util.WithMaxBackoff(disassociateRetryMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_ListAssociatedFleets.html
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DisassociateFleet.html
+		util.WithRetryOnFns(
+			func(err error) bool {
+				return errors.Is(err, ErrStackStillAssociated)
+			},
+			util.IsConcurrentModificationException,
+			util.IsOperationNotPermittedException,
+		),
+	)
+}
+
+func (r *resource) listAssociatedFleets(ctx context.Context, name string) ([]string, error) {
+	var fleetNames []string
+	var nextToken *string
+
+	for {
+		out, err := r.appstreamClient.ListAssociatedFleets(ctx, &awsappstream.ListAssociatedFleetsInput{
+			StackName: aws.String(name),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		fleetNames = append(fleetNames, out.Names...)
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	return fleetNames, nil
+}
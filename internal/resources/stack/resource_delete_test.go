@@ -0,0 +1,111 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/fake"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func withoutDisassociateWait(t *testing.T) {
+	t.Helper()
+
+	initBackoff, maxBackoff := disassociateRetryInitBackoff, disassociateRetryMaxBackoff
+	disassociateRetryInitBackoff, disassociateRetryMaxBackoff = time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		disassociateRetryInitBackoff, disassociateRetryMaxBackoff = initBackoff, maxBackoff
+	})
+}
+
+func TestResourceDelete_AssociatedFleets(t *testing.T) {
+	withoutDisassociateWait(t)
+
+	// more fleets than fit on one page of the fake backend
+	fleetNames := []string{"fleet-a", "fleet-b", "fleet-c"}
+
+	tests := []struct {
+		name         string
+		forceDestroy bool
+		wantError    string
+	}{
+		{
+			name:      "in_use",
+			wantError: "Error Deleting AWS AppStream Stack",
+		},
+		{
+			name:         "force_destroy",
+			forceDestroy: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			backend := fake.New()
+
+			_, err := backend.CreateStack(ctx, &awsappstream.CreateStackInput{Name: aws.String("stack")})
+			require.NoError(t, err)
+
+			for _, fleetName := range fleetNames {
+				_, err := backend.CreateFleet(ctx, &awsappstream.CreateFleetInput{
+					Name:            aws.String(fleetName),
+					InstanceType:    aws.String("stream.standard.small"),
+					ImageName:       aws.String("image"),
+					ComputeCapacity: &awstypes.ComputeCapacity{DesiredInstances: aws.Int32(1)},
+				})
+				require.NoError(t, err)
+
+				_, err = backend.AssociateFleet(ctx, &awsappstream.AssociateFleetInput{
+					FleetName: aws.String(fleetName),
+					StackName: aws.String("stack"),
+				})
+				require.NoError(t, err)
+			}
+
+			r := &resource{}
+			var configureResp tfresource.ConfigureResponse
+			r.Configure(ctx, tfresource.ConfigureRequest{ProviderData: backend.Metadata(nil)}, &configureResp)
+			require.False(t, configureResp.Diagnostics.HasError(), "%v", configureResp.Diagnostics)
+
+			var schemaResp tfresource.SchemaResponse
+			r.Schema(ctx, tfresource.SchemaRequest{}, &schemaResp)
+
+			state := fake.State(t, schemaResp.Schema, map[string]any{
+				"id":            "stack",
+				"name":          "stack",
+				"force_destroy": tt.forceDestroy,
+			})
+
+			var resp tfresource.DeleteResponse
+			r.Delete(ctx, tfresource.DeleteRequest{State: state}, &resp)
+
+			_, err = backend.DescribeStacks(ctx, &awsappstream.DescribeStacksInput{Names: []string{"stack"}})
+
+			if tt.wantError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				require.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+				require.NoError(t, err)
+				return
+			}
+
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			require.True(t, util.IsResourceNotFoundException(err))
+
+			for _, fleetName := range fleetNames {
+				out, err := backend.ListAssociatedStacks(ctx, &awsappstream.ListAssociatedStacksInput{FleetName: aws.String(fleetName)})
+				require.NoError(t, err)
+				require.Empty(t, out.Names)
+			}
+		})
+	}
+}
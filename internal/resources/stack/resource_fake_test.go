@@ -0,0 +1,76 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/fake"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func testFakeStackConfig(name, description, environment string) string {
+	return testhelpers.TestFakeProviderConfig() + testAccStackResource(name, fmt.Sprintf(`
+  description = %q
+
+  tags = {
+    Environment = %q
+  }
+`, description, environment))
+}
+
+func testFakeStackDestroyed(backend *fake.Backend, name string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		_, err := backend.DescribeStacks(context.Background(), &awsappstream.DescribeStacksInput{
+			Names: []string{name},
+		})
+		if !util.IsAppStreamNotFound(err) {
+			return fmt.Errorf("stack %q still exists: %v", name, err)
+		}
+		return nil
+	}
+}
+
+func TestFakeStack_lifecycle(t *testing.T) {
+	backend := fake.New()
+	name := "tf-fake-stack"
+	resourceName := "awsappstream_stack.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestFakePreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.FakeProtoV6ProviderFactories(backend),
+		CheckDestroy:             testFakeStackDestroyed(backend, name),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeStackConfig(name, "created", "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "arn", "arn:aws:appstream:us-east-1:123456789012:stack/"+name),
+					resource.TestCheckResourceAttr(resourceName, "description", "created"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "test"),
+				),
+			},
+			{
+				Config: testFakeStackConfig(name, "updated", "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "prod"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
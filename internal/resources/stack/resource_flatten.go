@@ -348,3 +348,12 @@ func flattenStreamingExperienceSettingsResource(
 	diags.Append(d...)
 	return obj
 }
+
+func flattenForceDestroy(prior types.Bool) types.Bool {
+	// provider-only setting. aws knows nothing about it
+	if prior.IsNull() || prior.IsUnknown() {
+		return types.BoolValue(false)
+	}
+
+	return prior
+}
@@ -96,18 +96,21 @@ func (r *resource) readStack(ctx context.Context, prior model) (*model, diag.Dia
 		UserSettings:                flattenUserSettingsResource(ctx, prior.UserSettings, stack.UserSettings, &diags),
 		ApplicationSettings:         flattenApplicationSettingsResource(ctx, prior.ApplicationSettings, stack.ApplicationSettings, &diags),
 		Tags:                        types.MapNull(types.StringType),
+		TagsAll:                     types.MapNull(types.StringType),
 		AccessEndpoints:             flattenAccessEndpointsResource(ctx, prior.AccessEndpoints, stack.AccessEndpoints, &diags),
 		EmbedHostDomains:            util.FlattenStateOwnedStringSet(ctx, prior.EmbedHostDomains, stack.EmbedHostDomains, &diags),
 		StreamingExperienceSettings: flattenStreamingExperienceSettingsResource(ctx, prior.StreamingExperienceSettings, stack.StreamingExperienceSettings, &diags),
+		ForceDestroy:                flattenForceDestroy(prior.ForceDestroy),
 		ARN:                         util.StringOrNull(stack.Arn),
 		CreatedTime:                 util.StringFromTime(stack.CreatedTime),
 		StackErrors:                 flattenStackErrorsData(ctx, stack.StackErrors, &diags),
 	}
 
 	if !state.ARN.IsNull() {
-		tags, tagDiags := r.tags.Read(ctx, state.ARN.ValueString())
+		tags, tagsAll, tagDiags := r.tags.ReadResource(ctx, state.ARN.ValueString(), prior.Tags)
 		diags.Append(tagDiags...)
 		state.Tags = tags
+		state.TagsAll = tagsAll
 	}
 
 	if diags.HasError() {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -208,6 +209,15 @@ func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *t
 					},
 				},
 			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "Whether to disassociate all fleets before deleting the stack.",
+				MarkdownDescription: "Whether to disassociate all fleets from the stack before it is deleted, " +
+					"including associations that are not managed by Terraform. AWS refuses to delete associated stacks. " +
+					"Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"tags": schema.MapAttribute{
 				Description:         "Tags applied to the AppStream stack.",
 				MarkdownDescription: "A map of tags assigned to the AppStream stack.",
@@ -231,6 +241,13 @@ func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *t
 					),
 				},
 			},
+			"tags_all": schema.MapAttribute{
+				Description: "All tags of the AppStream stack, including provider default tags.",
+				MarkdownDescription: "A map of all tags assigned to the AppStream stack, " +
+					"including those inherited from the provider `default_tags`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"access_endpoints": schema.SetNestedAttribute{
 				Description:         "VPC access endpoints for the stack.",
 				MarkdownDescription: "Interface VPC endpoints through which users can connect to the stack.",
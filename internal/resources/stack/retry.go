@@ -10,3 +10,10 @@ const (
 	createRetryInitBackoff = 5 * time.Second
 	createRetryMaxBackoff  = 1 * time.Minute
 )
+
+// variables so that tests against the fake backend can wait for disassociations without delay
+var (
+	disassociateRetryTimeout     = 5 * time.Minute
+	disassociateRetryInitBackoff = 2 * time.Second
+	disassociateRetryMaxBackoff  = 1 * time.Minute
+)
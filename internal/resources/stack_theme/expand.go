@@ -0,0 +1,53 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack_theme
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func expandFooterLinks(ctx context.Context, list types.List, diags *diag.Diagnostics) []awstypes.ThemeFooterLink {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var links []footerLinkModel
+	diags.Append(list.ElementsAs(ctx, &links, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	out := make([]awstypes.ThemeFooterLink, 0, len(links))
+	for _, l := range links {
+		out = append(out, awstypes.ThemeFooterLink{
+			DisplayName:   util.StringPointerOrNil(l.DisplayName),
+			FooterLinkURL: util.StringPointerOrNil(l.FooterLinkURL),
+		})
+	}
+
+	return out
+}
+
+func expandS3Location(ctx context.Context, obj types.Object, diags *diag.Diagnostics) *awstypes.S3Location {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	var m s3LocationModel
+	diags.Append(obj.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &awstypes.S3Location{
+		S3Bucket: util.StringPointerOrNil(m.S3Bucket),
+		S3Key:    util.StringPointerOrNil(m.S3Key),
+	}
+}
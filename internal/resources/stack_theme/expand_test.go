@@ -0,0 +1,65 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack_theme
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFooterLinksRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty_is_null", func(t *testing.T) {
+		var diags diag.Diagnostics
+		got := flattenFooterLinks(ctx, nil, &diags)
+		require.False(t, diags.HasError())
+		require.True(t, got.IsNull())
+		require.Nil(t, expandFooterLinks(ctx, got, &diags))
+	})
+
+	t.Run("keeps_order", func(t *testing.T) {
+		links := []awstypes.ThemeFooterLink{
+			{DisplayName: aws.String("Support"), FooterLinkURL: aws.String("https://support.example.com")},
+			{DisplayName: aws.String("About"), FooterLinkURL: aws.String("https://example.com/about")},
+		}
+
+		var diags diag.Diagnostics
+		got := expandFooterLinks(ctx, flattenFooterLinks(ctx, links, &diags), &diags)
+		require.False(t, diags.HasError())
+		require.Equal(t, links, got)
+	})
+}
+
+func TestExpandS3Location(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("null", func(t *testing.T) {
+		var diags diag.Diagnostics
+		require.Nil(t, expandS3Location(ctx, types.ObjectNull(s3LocationObjectType.AttrTypes), &diags))
+		require.False(t, diags.HasError())
+	})
+
+	t.Run("value", func(t *testing.T) {
+		obj := types.ObjectValueMust(s3LocationObjectType.AttrTypes, map[string]attr.Value{
+			"s3_bucket": types.StringValue("branding"),
+			"s3_key":    types.StringValue("logo.png"),
+		})
+
+		var diags diag.Diagnostics
+		got := expandS3Location(ctx, obj, &diags)
+		require.False(t, diags.HasError())
+		require.Equal(t, &awstypes.S3Location{
+			S3Bucket: aws.String("branding"),
+			S3Key:    aws.String("logo.png"),
+		}, got)
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack_theme
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var footerLinkObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"display_name":    types.StringType,
+		"footer_link_url": types.StringType,
+	},
+}
+
+var s3LocationObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"s3_bucket": types.StringType,
+		"s3_key":    types.StringType,
+	},
+}
+
+func flattenFooterLinks(ctx context.Context, awsLinks []awstypes.ThemeFooterLink, diags *diag.Diagnostics) types.List {
+	// footer_links must contain at least one link, so a theme without links is null
+	if len(awsLinks) == 0 {
+		return types.ListNull(footerLinkObjectType)
+	}
+
+	out := make([]footerLinkModel, 0, len(awsLinks))
+	for _, l := range awsLinks {
+		out = append(out, footerLinkModel{
+			DisplayName:   util.StringOrNull(l.DisplayName),
+			FooterLinkURL: util.StringOrNull(l.FooterLinkURL),
+		})
+	}
+
+	listVal, d := types.ListValueFrom(ctx, footerLinkObjectType, out)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ListNull(footerLinkObjectType)
+	}
+
+	return listVal
+}
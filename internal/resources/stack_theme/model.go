@@ -0,0 +1,45 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack_theme
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type model struct {
+	// ID is a synthetic identifier composed of "<stack_name>".
+	ID types.String `tfsdk:"id"`
+	// StackName is the name of the stack the theme belongs to (required).
+	StackName types.String `tfsdk:"stack_name"`
+	// TitleText is the title displayed at the top of the browser tab (required).
+	TitleText types.String `tfsdk:"title_text"`
+	// ThemeStyling is the color theme applied to links, text and buttons (required).
+	ThemeStyling types.String `tfsdk:"theme_styling"`
+	// FooterLinks is the ordered list of links displayed in the catalog page footer (optional).
+	FooterLinks types.List `tfsdk:"footer_links"`
+	// OrganizationLogoS3Location is the S3 location of the organization logo (required).
+	OrganizationLogoS3Location types.Object `tfsdk:"organization_logo_s3_location"`
+	// FaviconS3Location is the S3 location of the favicon (required).
+	FaviconS3Location types.Object `tfsdk:"favicon_s3_location"`
+	// State is whether the theme is shown to users (optional, computed).
+	State types.String `tfsdk:"state"`
+	// ThemeOrganizationLogoURL is the URL AppStream serves the organization logo from (computed).
+	ThemeOrganizationLogoURL types.String `tfsdk:"theme_organization_logo_url"`
+	// ThemeFaviconURL is the URL AppStream serves the favicon from (computed).
+	ThemeFaviconURL types.String `tfsdk:"theme_favicon_url"`
+	// CreatedTime is the timestamp when the theme was created (computed).
+	CreatedTime types.String `tfsdk:"created_time"`
+}
+
+type footerLinkModel struct {
+	// DisplayName is the name of the link as displayed to users (required).
+	DisplayName types.String `tfsdk:"display_name"`
+	// FooterLinkURL is the URL the link points to (required).
+	FooterLinkURL types.String `tfsdk:"footer_link_url"`
+}
+
+type s3LocationModel struct {
+	// S3Bucket is the name of the S3 bucket (required).
+	S3Bucket types.String `tfsdk:"s3_bucket"`
+	// S3Key is the S3 object key (required).
+	S3Key types.String `tfsdk:"s3_key"`
+}
@@ -0,0 +1,127 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack_theme
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	var plan model
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	if plan.StackName.IsNull() || plan.StackName.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Plan",
+			"Cannot create stack theme because stack_name must be known.",
+		)
+		return
+	}
+
+	stackName := plan.StackName.ValueString()
+
+	input := &awsappstream.CreateThemeForStackInput{
+		StackName:                  aws.String(stackName),
+		TitleText:                  util.StringPointerOrNil(plan.TitleText),
+		ThemeStyling:               awstypes.ThemeStyling(plan.ThemeStyling.ValueString()),
+		FooterLinks:                expandFooterLinks(ctx, plan.FooterLinks, &resp.Diagnostics),
+		OrganizationLogoS3Location: expandS3Location(ctx, plan.OrganizationLogoS3Location, &resp.Diagnostics),
+		FaviconS3Location:          expandS3Location(ctx, plan.FaviconS3Location, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_CreateThemeForStack.html
+	err := util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			_, err := r.appstreamClient.CreateThemeForStack(ctx, input)
+			return err
+		},
+		util.WithTimeout(createRetryTimeout),
+		util.WithInitBackoff(createRetryInitBackoff),
+		util.WithMaxBackoff(createRetryMaxBackoff),
+		util.WithRetryOnFns(
+			util.IsConcurrentModificationException,
+		),
+	)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		if util.IsResourceAlreadyExists(err) {
+			resp.Diagnostics.AddError(
+				"AWS AppStream Stack Theme Already Exists",
+				fmt.Sprintf(
+					"Stack %q already has a theme. To manage it with Terraform, import it using:\n\n"+
+						"  terraform import <resource_address> %q",
+					stackName, stackName,
+				),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating AWS AppStream Stack Theme",
+			fmt.Sprintf("Could not create theme of stack %q: %v", stackName, err),
+		)
+		return
+	}
+
+	// CreateThemeForStack has no state. a theme is created enabled and disabled afterwards
+	if plan.State.ValueString() == string(awstypes.ThemeStateDisabled) {
+		err := r.updateTheme(ctx, &awsappstream.UpdateThemeForStackInput{
+			StackName: aws.String(stackName),
+			State:     awstypes.ThemeStateDisabled,
+		})
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Creating AWS AppStream Stack Theme",
+				fmt.Sprintf("Could not disable theme of stack %q: %v", stackName, err),
+			)
+			return
+		}
+	}
+
+	newState, diags := r.readTheme(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"AWS AppStream Stack Theme Not Found After Creation",
+			fmt.Sprintf("The theme of stack %q was created but could not be read back.", stackName),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
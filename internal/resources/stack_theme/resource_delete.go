@@ -0,0 +1,49 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack_theme
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	var state model
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	stackName := state.StackName.ValueString()
+
+	_, err := r.appstreamClient.DeleteThemeForStack(ctx, &awsappstream.DeleteThemeForStackInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		// if the theme or its stack is already gone, that's fine for delete.
+		if util.IsAppStreamNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting AWS AppStream Stack Theme",
+			fmt.Sprintf("Could not delete theme of stack %q: %v", stackName, err),
+		)
+		return
+	}
+}
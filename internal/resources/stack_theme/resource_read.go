@@ -0,0 +1,110 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack_theme
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Read(ctx context.Context, req tfresource.ReadRequest, resp *tfresource.ReadResponse) {
+	var state model
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	newState, diags := r.readTheme(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *resource) readTheme(ctx context.Context, prior model) (*model, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	stackName := prior.StackName.ValueString()
+
+	out, err := r.appstreamClient.DescribeThemeForStack(ctx, &awsappstream.DescribeThemeForStackInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return nil, diags
+		}
+
+		if util.IsAppStreamNotFound(err) {
+			return nil, diags
+		}
+
+		diags.AddError(
+			"Error Reading AWS AppStream Stack Theme",
+			fmt.Sprintf("Could not read theme of stack %q: %v", stackName, err),
+		)
+		return nil, diags
+	}
+
+	if out.Theme == nil {
+		return nil, diags
+	}
+	theme := out.Theme
+
+	state := &model{
+		ID:                         types.StringValue(stackName),
+		StackName:                  types.StringValue(stackName),
+		TitleText:                  util.StringOrNull(theme.ThemeTitleText),
+		ThemeStyling:               types.StringNull(),
+		FooterLinks:                flattenFooterLinks(ctx, theme.ThemeFooterLinks, &diags),
+		OrganizationLogoS3Location: prior.OrganizationLogoS3Location,
+		FaviconS3Location:          prior.FaviconS3Location,
+		State:                      types.StringNull(),
+		ThemeOrganizationLogoURL:   util.StringOrNull(theme.ThemeOrganizationLogoURL),
+		ThemeFaviconURL:            util.StringOrNull(theme.ThemeFaviconURL),
+		CreatedTime:                util.StringFromTime(theme.CreatedTime),
+	}
+
+	if theme.ThemeStyling != "" {
+		state.ThemeStyling = types.StringValue(string(theme.ThemeStyling))
+	}
+	if theme.State != "" {
+		state.State = types.StringValue(string(theme.State))
+	}
+
+	// the S3 locations are write-only. keep the configured ones, they are null after an import
+	if state.OrganizationLogoS3Location.IsNull() || state.OrganizationLogoS3Location.IsUnknown() {
+		state.OrganizationLogoS3Location = types.ObjectNull(s3LocationObjectType.AttrTypes)
+	}
+	if state.FaviconS3Location.IsNull() || state.FaviconS3Location.IsUnknown() {
+		state.FaviconS3Location = types.ObjectNull(s3LocationObjectType.AttrTypes)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return state, diags
+}
@@ -0,0 +1,178 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack_theme
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the theme of an AWS AppStream Stack",
+		MarkdownDescription: "Manages the custom branding theme of an AppStream stack. " +
+			"The theme defines the title, footer links, color styling, favicon and organization logo " +
+			"that users see on the streaming application catalog page.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the AppStream stack theme.",
+				MarkdownDescription: "A synthetic identifier for the stack theme, equal to the stack name. " +
+					"This value is managed by the provider and cannot be set manually.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "Name of the AppStream stack.",
+				MarkdownDescription: "The name of the AppStream stack the theme belongs to. " +
+					"Changing this value forces the stack theme to be replaced.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"title_text": schema.StringAttribute{
+				Description:         "Title of the streaming site.",
+				MarkdownDescription: "The title that is displayed at the top of the browser tab during users' streaming sessions.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 300),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[-@./#&+\w\s]*$`),
+						"must match ^[-@./#&+\\w\\s]*$",
+					),
+				},
+			},
+			"theme_styling": schema.StringAttribute{
+				Description: "Color theme of the streaming site.",
+				MarkdownDescription: "The color theme that is applied to website links, text, and buttons. " +
+					"Valid values are `LIGHT_BLUE`, `BLUE`, `PINK` and `RED`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"LIGHT_BLUE",
+						"BLUE",
+						"PINK",
+						"RED",
+					),
+				},
+			},
+			"footer_links": schema.ListNestedAttribute{
+				Description: "Links in the catalog page footer.",
+				MarkdownDescription: "The links that are displayed in the footer of the streaming application catalog page, " +
+					"in display order.",
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"display_name": schema.StringAttribute{
+							Description:         "Name of the link.",
+							MarkdownDescription: "The name of the link that is displayed to users.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 300),
+								stringvalidator.RegexMatches(
+									regexp.MustCompile(`^[-@./#&+\w\s]*$`),
+									"must match ^[-@./#&+\\w\\s]*$",
+								),
+							},
+						},
+						"footer_link_url": schema.StringAttribute{
+							Description:         "URL of the link.",
+							MarkdownDescription: "The URL of the website the link points to.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 1000),
+								util.ValidURL(),
+							},
+						},
+					},
+				},
+			},
+			"organization_logo_s3_location": schema.SingleNestedAttribute{
+				Description: "S3 location of the organization logo.",
+				MarkdownDescription: "The S3 location of the organization logo that appears on the streaming application catalog page. " +
+					"AWS does not return this location, so changes made outside of Terraform are not detected.",
+				Required:   true,
+				Attributes: s3LocationAttributes("organization logo"),
+			},
+			"favicon_s3_location": schema.SingleNestedAttribute{
+				Description: "S3 location of the favicon.",
+				MarkdownDescription: "The S3 location of the favicon that is displayed in the browser tab during streaming sessions. " +
+					"AWS does not return this location, so changes made outside of Terraform are not detected.",
+				Required:   true,
+				Attributes: s3LocationAttributes("favicon"),
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the theme.",
+				MarkdownDescription: "Whether the theme is displayed to users. " +
+					"Valid values are `ENABLED` and `DISABLED`. Defaults to `ENABLED`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("ENABLED"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("ENABLED", "DISABLED"),
+				},
+			},
+			"theme_organization_logo_url": schema.StringAttribute{
+				Description:         "URL of the organization logo.",
+				MarkdownDescription: "The URL AppStream serves the organization logo from.",
+				Computed:            true,
+			},
+			"theme_favicon_url": schema.StringAttribute{
+				Description:         "URL of the favicon.",
+				MarkdownDescription: "The URL AppStream serves the favicon from.",
+				Computed:            true,
+			},
+			"created_time": schema.StringAttribute{
+				Description:         "Creation time of the theme.",
+				MarkdownDescription: "The time the stack theme was created, in RFC3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func s3LocationAttributes(object string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"s3_bucket": schema.StringAttribute{
+			Description:         "S3 bucket name.",
+			MarkdownDescription: "The name of the Amazon S3 bucket.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(3, 63),
+			},
+		},
+		"s3_key": schema.StringAttribute{
+			Description:         "S3 object key.",
+			MarkdownDescription: "The S3 object key of the " + object + ".",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 1024),
+			},
+		},
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack_theme_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccStackThemeConfig(name, bucket, styling, state string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_stack" "test" {
+  name = %[1]q
+}
+
+resource "awsappstream_stack_theme" "test" {
+  stack_name    = awsappstream_stack.test.name
+  title_text    = "Example Corp"
+  theme_styling = %[3]q
+  state         = %[4]q
+
+  footer_links = [
+    {
+      display_name    = "IT Support"
+      footer_link_url = "https://support.example.com"
+    }
+  ]
+
+  organization_logo_s3_location = {
+    s3_bucket = %[2]q
+    s3_key    = "logo.png"
+  }
+
+  favicon_s3_location = {
+    s3_bucket = %[2]q
+    s3_key    = "favicon.ico"
+  }
+}
+`, name, bucket, styling, state)
+}
+
+func TestAccStackTheme_basic(t *testing.T) {
+	bucket := testhelpers.TestAccThemeS3Bucket(t)
+	name := acctest.RandomWithPrefix("tf-acc-stack-theme")
+	resourceName := "awsappstream_stack_theme.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackThemeConfig(name, bucket, "BLUE", "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "stack_name", name),
+					resource.TestCheckResourceAttr(resourceName, "theme_styling", "BLUE"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "footer_links.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "footer_links.0.display_name", "IT Support"),
+					resource.TestCheckResourceAttrSet(resourceName, "theme_organization_logo_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "theme_favicon_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+				),
+			},
+			{
+				Config: testAccStackThemeConfig(name, bucket, "RED", "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "theme_styling", "RED"),
+					resource.TestCheckResourceAttr(resourceName, "state", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// AWS does not return the S3 locations of the theme images
+				ImportStateVerifyIgnore: []string{
+					"organization_logo_s3_location",
+					"favicon_s3_location",
+				},
+			},
+		},
+	})
+}
+
+func TestAccStackTheme_invalidFooterLinkURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelpers.TestAccProviderBasicConfig() + `
+resource "awsappstream_stack_theme" "test" {
+  stack_name    = "tf-acc-stack-theme-invalid-url"
+  title_text    = "Example Corp"
+  theme_styling = "BLUE"
+
+  footer_links = [
+    {
+      display_name    = "IT Support"
+      footer_link_url = "support.example.com"
+    }
+  ]
+
+  organization_logo_s3_location = {
+    s3_bucket = "bucket"
+    s3_key    = "logo.png"
+  }
+
+  favicon_s3_location = {
+    s3_bucket = "bucket"
+    s3_key    = "favicon.ico"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`must be a valid http or https URL`),
+			},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack_theme
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Update(ctx context.Context, req tfresource.UpdateRequest, resp *tfresource.UpdateResponse) {
+	var plan model
+	var state model
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	stackName := plan.StackName.ValueString()
+
+	input := &awsappstream.UpdateThemeForStackInput{
+		StackName:    aws.String(stackName),
+		TitleText:    util.StringPointerOrNil(plan.TitleText),
+		ThemeStyling: awstypes.ThemeStyling(plan.ThemeStyling.ValueString()),
+		State:        awstypes.ThemeState(plan.State.ValueString()),
+	}
+
+	if !plan.FooterLinks.IsUnknown() {
+		if plan.FooterLinks.IsNull() {
+			input.AttributesToDelete = []awstypes.ThemeAttribute{awstypes.ThemeAttributeFooterLinks}
+		} else {
+			input.FooterLinks = expandFooterLinks(ctx, plan.FooterLinks, &resp.Diagnostics)
+		}
+	}
+
+	// AppStream copies the images when they are set. only send the locations that changed
+	if !plan.OrganizationLogoS3Location.Equal(state.OrganizationLogoS3Location) {
+		input.OrganizationLogoS3Location = expandS3Location(ctx, plan.OrganizationLogoS3Location, &resp.Diagnostics)
+	}
+	if !plan.FaviconS3Location.Equal(state.FaviconS3Location) {
+		input.FaviconS3Location = expandS3Location(ctx, plan.FaviconS3Location, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateTheme(ctx, input); err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		if util.IsAppStreamNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating AWS AppStream Stack Theme",
+			fmt.Sprintf("Could not update theme of stack %q: %v", stackName, err),
+		)
+		return
+	}
+
+	newState, diags := r.readTheme(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *resource) updateTheme(ctx context.Context, input *awsappstream.UpdateThemeForStackInput) error {
+	// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_UpdateThemeForStack.html
+	return util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			_, err := r.appstreamClient.UpdateThemeForStack(ctx, input)
+			return err
+		},
+		util.WithTimeout(updateRetryTimeout),
+		util.WithInitBackoff(updateRetryInitBackoff),
+		util.WithMaxBackoff(updateRetryMaxBackoff),
+		util.WithRetryOnFns(
+			util.IsConcurrentModificationException,
+		),
+	)
+}
@@ -0,0 +1,16 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stack_theme
+
+import "time"
+
+const (
+	createRetryTimeout     = 5 * time.Minute
+	createRetryInitBackoff = 2 * time.Second
+	createRetryMaxBackoff  = 30 * time.Second
+
+	updateRetryTimeout     = 5 * time.Minute
+	updateRetryInitBackoff = 2 * time.Second
+	updateRetryMaxBackoff  = 30 * time.Second
+)
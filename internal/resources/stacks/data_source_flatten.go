@@ -0,0 +1,70 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stacks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var stackObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":         types.StringType,
+		"arn":          types.StringType,
+		"display_name": types.StringType,
+		"description":  types.StringType,
+		"redirect_url": types.StringType,
+		"feedback_url": types.StringType,
+		"created_time": types.StringType,
+		"tags":         types.MapType{ElemType: types.StringType},
+	},
+}
+
+// flattenStacks returns the names, ARNs and key attributes of stacks. Empty results are empty lists, not null.
+func flattenStacks(
+	ctx context.Context, stacks []awstypes.Stack, tagsByARN map[string]types.Map, diags *diag.Diagnostics,
+) (names types.List, arns types.List, stackList types.List) {
+	nameValues := make([]string, 0, len(stacks))
+	arnValues := make([]string, 0, len(stacks))
+	models := make([]stackModel, 0, len(stacks))
+
+	for _, stack := range stacks {
+		arn := aws.ToString(stack.Arn)
+
+		stackTags, ok := tagsByARN[arn]
+		if !ok {
+			stackTags = types.MapNull(types.StringType)
+		}
+
+		nameValues = append(nameValues, aws.ToString(stack.Name))
+		arnValues = append(arnValues, arn)
+		models = append(models, stackModel{
+			Name:        util.StringOrNull(stack.Name),
+			ARN:         util.StringOrNull(stack.Arn),
+			DisplayName: util.StringOrNull(stack.DisplayName),
+			Description: util.StringOrNull(stack.Description),
+			RedirectURL: util.StringOrNull(stack.RedirectURL),
+			FeedbackURL: util.StringOrNull(stack.FeedbackURL),
+			CreatedTime: util.StringFromTime(stack.CreatedTime),
+			Tags:        stackTags,
+		})
+	}
+
+	names, d := types.ListValueFrom(ctx, types.StringType, nameValues)
+	diags.Append(d...)
+
+	arns, d = types.ListValueFrom(ctx, types.StringType, arnValues)
+	diags.Append(d...)
+
+	stackList, d = types.ListValueFrom(ctx, stackObjectType, models)
+	diags.Append(d...)
+
+	return names, arns, stackList
+}
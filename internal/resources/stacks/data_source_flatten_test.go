@@ -0,0 +1,68 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stacks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenStacks(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty_is_not_null", func(t *testing.T) {
+		var diags diag.Diagnostics
+		names, arns, stacks := flattenStacks(ctx, nil, nil, &diags)
+		require.False(t, diags.HasError())
+		require.False(t, names.IsNull())
+		require.Empty(t, names.Elements())
+		require.False(t, arns.IsNull())
+		require.Empty(t, arns.Elements())
+		require.False(t, stacks.IsNull())
+		require.Empty(t, stacks.Elements())
+	})
+
+	t.Run("tags_are_attached_by_arn", func(t *testing.T) {
+		prodTags := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"env": types.StringValue("prod"),
+		})
+
+		var diags diag.Diagnostics
+		names, arns, stacks := flattenStacks(ctx, []awstypes.Stack{
+			{
+				Name:        aws.String("a"),
+				Arn:         aws.String("arn:a"),
+				DisplayName: aws.String("Stack A"),
+				RedirectURL: aws.String("https://example.com"),
+			},
+			{
+				Name: aws.String("b"),
+				Arn:  aws.String("arn:b"),
+			},
+		}, map[string]types.Map{"arn:a": prodTags}, &diags)
+		require.False(t, diags.HasError())
+
+		require.Equal(t, []attr.Value{types.StringValue("a"), types.StringValue("b")}, names.Elements())
+		require.Equal(t, []attr.Value{types.StringValue("arn:a"), types.StringValue("arn:b")}, arns.Elements())
+
+		var models []stackModel
+		diags.Append(stacks.ElementsAs(ctx, &models, false)...)
+		require.False(t, diags.HasError())
+		require.Len(t, models, 2)
+
+		require.Equal(t, "Stack A", models[0].DisplayName.ValueString())
+		require.Equal(t, "https://example.com", models[0].RedirectURL.ValueString())
+		require.True(t, models[0].Tags.Equal(prodTags))
+
+		require.True(t, models[1].DisplayName.IsNull())
+		require.True(t, models[1].Tags.IsNull())
+	})
+}
@@ -0,0 +1,32 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stacks
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type dataSourceModel struct {
+	// ID is a synthetic identifier equal to the configured region.
+	ID types.String `tfsdk:"id"`
+	// NameRegex only selects stacks whose name matches the regular expression (optional).
+	NameRegex types.String `tfsdk:"name_regex"`
+	// Tags only selects stacks carrying all of these tags (optional).
+	Tags types.Map `tfsdk:"tags"`
+	// Names are the names of the matching stacks (computed).
+	Names types.List `tfsdk:"names"`
+	// ARNs are the ARNs of the matching stacks (computed).
+	ARNs types.List `tfsdk:"arns"`
+	// Stacks are the key attributes of the matching stacks (computed).
+	Stacks types.List `tfsdk:"stacks"`
+}
+
+type stackModel struct {
+	Name        types.String `tfsdk:"name"`
+	ARN         types.String `tfsdk:"arn"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+	RedirectURL types.String `tfsdk:"redirect_url"`
+	FeedbackURL types.String `tfsdk:"feedback_url"`
+	CreatedTime types.String `tfsdk:"created_time"`
+	Tags        types.Map    `tfsdk:"tags"`
+}
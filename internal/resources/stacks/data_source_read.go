@@ -0,0 +1,107 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stacks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/tags"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+// stackResourceType is the Resource Groups Tagging resource type of AppStream stacks.
+const stackResourceType = "appstream:stack"
+
+func (ds *dataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config dataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	regex, err := util.RegexpOrNil(config.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Configuration",
+			fmt.Sprintf("Could not compile name_regex: %v", err),
+		)
+		return
+	}
+
+	stacks, err := ds.listStacks(ctx, regex)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading AWS AppStream Stacks",
+			fmt.Sprintf("Could not list stacks: %v", err),
+		)
+		return
+	}
+
+	stacks, tagsByARN, diags := tags.FilterByResourceType(
+		ctx, ds.tags, stackResourceType, config.Tags, stacks, func(s awstypes.Stack) *string { return s.Arn },
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(ds.region)
+	config.Names, config.ARNs, config.Stacks = flattenStacks(ctx, stacks, tagsByARN, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listStacks pages through DescribeStacks and returns the stacks matching regex, sorted by name.
+func (ds *dataSource) listStacks(ctx context.Context, regex *regexp.Regexp) ([]awstypes.Stack, error) {
+	var out []awstypes.Stack
+	var nextToken *string
+
+	for {
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeStacks.html
+		resp, err := ds.appstreamClient.DescribeStacks(ctx, &awsappstream.DescribeStacksInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, stack := range resp.Stacks {
+			if regex != nil && !regex.MatchString(aws.ToString(stack.Name)) {
+				continue
+			}
+			out = append(out, stack)
+		}
+
+		if resp.NextToken == nil || *resp.NextToken == "" {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return aws.ToString(out[i].Name) < aws.ToString(out[j].Name)
+	})
+
+	return out, nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stacks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/fake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceRead_Filters(t *testing.T) {
+	ctx := context.Background()
+	backend := fake.New()
+
+	stackTags := map[string]map[string]string{
+		"dev":      {"env": "dev"},
+		"prod":     {"env": "prod"},
+		"prod-b":   {"env": "prod"},
+		"untagged": nil,
+	}
+	for name, tags := range stackTags {
+		_, err := backend.CreateStack(ctx, &awsappstream.CreateStackInput{
+			Name: aws.String(name),
+			Tags: tags,
+		})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name       string
+		attributes map[string]any
+		want       []string
+	}{
+		{
+			name: "no_filter",
+			want: []string{"dev", "prod", "prod-b", "untagged"},
+		},
+		{
+			name:       "name_regex",
+			attributes: map[string]any{"name_regex": "^prod"},
+			want:       []string{"prod", "prod-b"},
+		},
+		{
+			name:       "tags",
+			attributes: map[string]any{"tags": map[string]string{"env": "dev"}},
+			want:       []string{"dev"},
+		},
+		{
+			name:       "tags_and_name_regex",
+			attributes: map[string]any{"tags": map[string]string{"env": "prod"}, "name_regex": "-b$"},
+			want:       []string{"prod-b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &dataSource{}
+			var configureResp datasource.ConfigureResponse
+			ds.Configure(ctx, datasource.ConfigureRequest{ProviderData: backend.Metadata(nil)}, &configureResp)
+			require.False(t, configureResp.Diagnostics.HasError(), "%v", configureResp.Diagnostics)
+
+			var schemaResp datasource.SchemaResponse
+			ds.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+			config := fake.Config(t, schemaResp.Schema, tt.attributes)
+
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+			ds.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var names []string
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("names"), &names)...)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			require.Equal(t, tt.want, names)
+		})
+	}
+}
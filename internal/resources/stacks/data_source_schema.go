@@ -0,0 +1,107 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stacks
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (ds *dataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List AWS AppStream Stacks",
+		MarkdownDescription: "Lists the AppStream stacks of the configured region. " +
+			"All filters are optional and combined, so only stacks matching every configured filter are returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the AppStream stacks.",
+				MarkdownDescription: "A synthetic identifier for the stacks, equal to the configured region.",
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression to match stack names.",
+				MarkdownDescription: "A regular expression used to match AppStream stack names. " +
+					"Uses Go regular expression syntax.",
+				Optional: true,
+				Validators: []validator.String{
+					util.ValidRegex(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of the AppStream stacks.",
+				MarkdownDescription: "Only return stacks carrying all of these tags. " +
+					"Tags are resolved through the Resource Groups Tagging API.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"names": schema.ListAttribute{
+				Description:         "Names of the AppStream stacks.",
+				MarkdownDescription: "The names of the matching stacks, sorted by name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"arns": schema.ListAttribute{
+				Description:         "ARNs of the AppStream stacks.",
+				MarkdownDescription: "The ARNs of the matching stacks, in the same order as `names`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"stacks": schema.ListNestedAttribute{
+				Description:         "AppStream stacks.",
+				MarkdownDescription: "The key attributes of the matching stacks, in the same order as `names`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description:         "Name of the AppStream stack.",
+							MarkdownDescription: "The name of the stack.",
+							Computed:            true,
+						},
+						"arn": schema.StringAttribute{
+							Description:         "ARN of the AppStream stack.",
+							MarkdownDescription: "The ARN of the stack.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							Description:         "Display name of the AppStream stack.",
+							MarkdownDescription: "The stack name displayed to end users, if set.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							Description:         "Description of the AppStream stack.",
+							MarkdownDescription: "The stack description, if set.",
+							Computed:            true,
+						},
+						"redirect_url": schema.StringAttribute{
+							Description:         "Redirect URL after the streaming session ends.",
+							MarkdownDescription: "The URL users are redirected to after their streaming session ends, if set.",
+							Computed:            true,
+						},
+						"feedback_url": schema.StringAttribute{
+							Description:         "Feedback URL.",
+							MarkdownDescription: "The URL users are redirected to when they click the Send Feedback link, if set.",
+							Computed:            true,
+						},
+						"created_time": schema.StringAttribute{
+							Description:         "Time the stack was created.",
+							MarkdownDescription: "The timestamp when the stack was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"tags": schema.MapAttribute{
+							Description:         "Tags applied to the AppStream stack.",
+							MarkdownDescription: "Tags assigned to the AppStream stack.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package stacks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccStacksDataSourceConfig(name string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_stack" "test" {
+  name         = %[1]q
+  display_name = "Test Stack"
+
+  tags = {
+    TestName = %[1]q
+  }
+}
+
+data "awsappstream_stacks" "by_tag" {
+  tags = {
+    TestName = awsappstream_stack.test.tags["TestName"]
+  }
+}
+
+data "awsappstream_stacks" "by_name" {
+  name_regex = "^${awsappstream_stack.test.name}$"
+}
+
+data "awsappstream_stacks" "no_match" {
+  name_regex = "^${awsappstream_stack.test.name}-missing$"
+}
+`, name)
+}
+
+func TestAccStacksDataSource_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-stacks-ds")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStacksDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.awsappstream_stacks.by_tag", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.awsappstream_stacks.by_tag", "names.0", name),
+					resource.TestCheckResourceAttrPair(
+						"data.awsappstream_stacks.by_tag", "arns.0",
+						"awsappstream_stack.test", "arn",
+					),
+					resource.TestCheckResourceAttr("data.awsappstream_stacks.by_tag", "stacks.0.tags.TestName", name),
+					resource.TestCheckResourceAttr("data.awsappstream_stacks.by_name", "stacks.#", "1"),
+					resource.TestCheckResourceAttr("data.awsappstream_stacks.by_name", "stacks.0.display_name", "Test Stack"),
+					resource.TestCheckResourceAttr("data.awsappstream_stacks.no_match", "names.#", "0"),
+				),
+			},
+		},
+	})
+}
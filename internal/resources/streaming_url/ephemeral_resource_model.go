@@ -0,0 +1,25 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package streaming_url
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ephemeralResourceModel struct {
+	// StackName is the name of the stack to stream from (required).
+	StackName types.String `tfsdk:"stack_name"`
+	// FleetName is the name of the fleet to stream from (required).
+	FleetName types.String `tfsdk:"fleet_name"`
+	// UserID is the identifier of the user the URL is created for (required).
+	UserID types.String `tfsdk:"user_id"`
+	// ApplicationID is the name of the application to launch after the session starts (optional).
+	ApplicationID types.String `tfsdk:"application_id"`
+	// Validity is the time in seconds the URL is valid for (optional).
+	Validity types.Int64 `tfsdk:"validity"`
+	// SessionContext is the session context passed to the streaming session (optional).
+	SessionContext types.String `tfsdk:"session_context"`
+	// StreamingURL is the URL that starts the streaming session (computed).
+	StreamingURL types.String `tfsdk:"streaming_url"`
+	// Expires is the time the URL expires (computed).
+	Expires types.String `tfsdk:"expires"`
+}
@@ -0,0 +1,60 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package streaming_url
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (e *ephemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config ephemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	stackName := config.StackName.ValueString()
+	fleetName := config.FleetName.ValueString()
+
+	input := &awsappstream.CreateStreamingURLInput{
+		StackName:      aws.String(stackName),
+		FleetName:      aws.String(fleetName),
+		UserId:         util.StringPointerOrNil(config.UserID),
+		ApplicationId:  util.StringPointerOrNil(config.ApplicationID),
+		SessionContext: util.StringPointerOrNil(config.SessionContext),
+	}
+	if !config.Validity.IsNull() && !config.Validity.IsUnknown() {
+		input.Validity = aws.Int64(config.Validity.ValueInt64())
+	}
+
+	// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_CreateStreamingURL.html
+	out, err := e.appstreamClient.CreateStreamingURL(ctx, input)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating AWS AppStream Streaming URL",
+			fmt.Sprintf("Could not create streaming URL for stack %q and fleet %q: %v", stackName, fleetName, err),
+		)
+		return
+	}
+
+	config.StreamingURL = util.StringOrNull(out.StreamingURL)
+	config.Expires = util.StringFromTime(out.Expires)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
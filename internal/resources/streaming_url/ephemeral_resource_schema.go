@@ -0,0 +1,95 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package streaming_url
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (e *ephemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create a temporary AWS AppStream streaming URL",
+		MarkdownDescription: "Creates a temporary URL to start an AppStream streaming session for a user of a stack and fleet. " +
+			"The URL is never written to the Terraform plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"stack_name": schema.StringAttribute{
+				Description:         "Name of the AppStream stack.",
+				MarkdownDescription: "The name of the AppStream stack to stream from.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"fleet_name": schema.StringAttribute{
+				Description:         "Name of the AppStream fleet.",
+				MarkdownDescription: "The name of the AppStream fleet to stream from. The fleet must be associated with the stack.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description:         "Identifier of the user.",
+				MarkdownDescription: "The identifier of the user the streaming URL is created for.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 128),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[\w+=,.@-]*$`),
+						"must match ^[\\w+=,.@-]*$",
+					),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				Description: "Application to launch.",
+				MarkdownDescription: "The name of the application to launch after the session starts. " +
+					"Use `Desktop` to launch directly to the operating system desktop of fleets with the desktop stream view.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"validity": schema.Int64Attribute{
+				Description:         "Validity of the URL in seconds.",
+				MarkdownDescription: "The time in seconds the streaming URL is valid for, between `1` and `604800`. AWS defaults to `60` seconds.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 604800),
+				},
+			},
+			"session_context": schema.StringAttribute{
+				Description:         "Session context.",
+				MarkdownDescription: "The session context that is passed to the streaming session, for example to launch an application with parameters.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"streaming_url": schema.StringAttribute{
+				Description:         "Streaming URL.",
+				MarkdownDescription: "The URL that starts the streaming session.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires": schema.StringAttribute{
+				Description:         "Expiry of the URL.",
+				MarkdownDescription: "The time the streaming URL expires, in RFC3339 format.",
+				Computed:            true,
+			},
+		},
+	}
+}
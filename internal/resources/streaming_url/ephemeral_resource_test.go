@@ -0,0 +1,63 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package streaming_url_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccStreamingURLConfig(stackName, fleetName string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+ephemeral "awsappstream_streaming_url" "test" {
+  stack_name = %q
+  fleet_name = %q
+  user_id    = "tf-acc-user"
+  validity   = 300
+}
+
+provider "echo" {
+  data = ephemeral.awsappstream_streaming_url.test
+}
+
+resource "echo" "test" {}
+`, stackName, fleetName)
+}
+
+func TestAccStreamingURLEphemeral_basic(t *testing.T) {
+	stackName, fleetName := testhelpers.TestAccStreamingStackFleet(t)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactoriesWithEcho,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamingURLConfig(stackName, fleetName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("streaming_url"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^https://`)),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("expires"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
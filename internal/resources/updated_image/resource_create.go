@@ -0,0 +1,157 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package updated_image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/image"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	var plan resourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	existingName := plan.ExistingImageName.ValueString()
+	name := plan.NewImageName.ValueString()
+
+	input := &awsappstream.CreateUpdatedImageInput{
+		ExistingImageName:   aws.String(existingName),
+		NewImageName:        aws.String(name),
+		NewImageDescription: util.StringPointerOrNil(plan.NewImageDescription),
+		NewImageDisplayName: util.StringPointerOrNil(plan.NewImageDisplayName),
+	}
+
+	if plan.DryRun.ValueBool() {
+		input.DryRun = aws.Bool(true)
+
+		out, err := r.createUpdatedImage(ctx, input)
+		if err != nil {
+			if util.IsContextCanceled(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Creating AWS AppStream Updated Image",
+				fmt.Sprintf("Could not check whether image %q can be updated: %v", existingName, err),
+			)
+			return
+		}
+
+		if !aws.ToBool(out.CanUpdateImage) {
+			resp.Diagnostics.AddError(
+				"AWS AppStream Image Is Up To Date",
+				fmt.Sprintf(
+					"Image %q already uses the latest AppStream agent and operating system updates. "+
+						"No updated image was created.",
+					existingName,
+				),
+			)
+			return
+		}
+
+		input.DryRun = nil
+	}
+
+	out, err := r.createUpdatedImage(ctx, input)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		if util.IsResourceAlreadyExists(err) {
+			resp.Diagnostics.AddError(
+				"AWS AppStream Image Already Exists",
+				fmt.Sprintf(
+					"An image named %q already exists. To manage it with Terraform, import it using:\n\n"+
+						"  terraform import <resource_address> %q",
+					name, name,
+				),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating AWS AppStream Updated Image",
+			fmt.Sprintf("Could not create updated image %q from image %q: %v", name, existingName, err),
+		)
+		return
+	}
+
+	plan.CanUpdateImage = types.BoolValue(aws.ToBool(out.CanUpdateImage))
+
+	if out.Image != nil && out.Image.Arn != nil {
+		_, tagDiags := r.tags.Apply(ctx, aws.ToString(out.Image.Arn), plan.Tags)
+		resp.Diagnostics.Append(tagDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err = image.WaitImageAvailable(ctx, r.appstreamClient, name)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Waiting for AWS AppStream Updated Image",
+			fmt.Sprintf("Image %q did not become available: %v", name, err),
+		)
+		// keep the image in state so terraform taints it and deletes it on the next apply
+	}
+
+	newState, diags := r.readUpdatedImage(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *resource) createUpdatedImage(
+	ctx context.Context, input *awsappstream.CreateUpdatedImageInput,
+) (*awsappstream.CreateUpdatedImageOutput, error) {
+
+	var out *awsappstream.CreateUpdatedImageOutput
+	err := util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			var err error
+			out, err = r.appstreamClient.CreateUpdatedImage(ctx, input)
+			return err
+		},
+		util.WithTimeout(createRetryTimeout),
+		util.WithInitBackoff(createRetryInitBackoff),
+		util.WithMaxBackoff(createRetryMaxBackoff),
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_CreateUpdatedImage.html
+		util.WithRetryOnFns(
+			util.IsConcurrentModificationException,
+		),
+	)
+
+	return out, err
+}
@@ -0,0 +1,49 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package updated_image
+
+import (
+	"context"
+	"fmt"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/image"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	var state resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	if state.NewImageName.IsNull() || state.NewImageName.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform State",
+			"Cannot delete updated image because new_image_name must be known.",
+		)
+		return
+	}
+
+	name := state.NewImageName.ValueString()
+
+	err := image.DeleteImage(ctx, r.appstreamClient, name)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting AWS AppStream Updated Image",
+			fmt.Sprintf("Could not delete image %q: %v", name, err),
+		)
+		return
+	}
+}
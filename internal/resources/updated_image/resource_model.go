@@ -0,0 +1,40 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package updated_image
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type resourceModel struct {
+	// ID is the identifier of the updated image.
+	// This is equal to the new image name (computed).
+	ID types.String `tfsdk:"id"`
+	// ExistingImageName is the name of the image to update (required).
+	ExistingImageName types.String `tfsdk:"existing_image_name"`
+	// NewImageName is the name of the updated image (required).
+	NewImageName types.String `tfsdk:"new_image_name"`
+	// NewImageDescription is the description of the updated image (optional, computed).
+	NewImageDescription types.String `tfsdk:"new_image_description"`
+	// NewImageDisplayName is the display name of the updated image (optional, computed).
+	NewImageDisplayName types.String `tfsdk:"new_image_display_name"`
+	// DryRun checks whether an update is available before the image is created (optional).
+	DryRun types.Bool `tfsdk:"dry_run"`
+	// CanUpdateImage indicates whether AWS reported an update for the existing image (computed).
+	CanUpdateImage types.Bool `tfsdk:"can_update_image"`
+	// Tags is the map of tags assigned to the updated image (optional).
+	Tags types.Map `tfsdk:"tags"`
+	// TagsAll is the map of all tags of the updated image, including provider default tags (computed).
+	TagsAll types.Map `tfsdk:"tags_all"`
+	// ARN is the ARN of the updated image (computed).
+	ARN types.String `tfsdk:"arn"`
+	// Platform is the operating system platform of the updated image (computed).
+	Platform types.String `tfsdk:"platform"`
+	// State is the current lifecycle state of the updated image (computed).
+	State types.String `tfsdk:"state"`
+	// AppstreamAgentVersion is the AppStream agent version of the updated image (computed).
+	AppstreamAgentVersion types.String `tfsdk:"appstream_agent_version"`
+	// CreatedTime is the timestamp when the updated image was created (computed).
+	CreatedTime types.String `tfsdk:"created_time"`
+	// ImageErrors is the list of errors reported by AWS for the updated image (computed).
+	ImageErrors types.Set `tfsdk:"image_errors"`
+}
@@ -0,0 +1,100 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package updated_image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/resources/image"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Read(ctx context.Context, req tfresource.ReadRequest, resp *tfresource.ReadResponse) {
+	var state resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	newState, diags := r.readUpdatedImage(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *resource) readUpdatedImage(ctx context.Context, prior resourceModel) (*resourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name := prior.NewImageName.ValueString()
+
+	img, err := image.DescribeImage(ctx, r.appstreamClient, name)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return nil, diags
+		}
+
+		diags.AddError(
+			"Error Reading AWS AppStream Updated Image",
+			fmt.Sprintf("Could not read image %q: %v", name, err),
+		)
+		return nil, diags
+	}
+
+	if img == nil {
+		return nil, diags
+	}
+
+	// aws reports neither the existing image nor the dry run result. keep the prior values
+	state := &resourceModel{
+		ID:                    types.StringValue(aws.ToString(img.Name)),
+		ExistingImageName:     prior.ExistingImageName,
+		NewImageName:          types.StringValue(aws.ToString(img.Name)),
+		NewImageDescription:   util.StringOrNull(img.Description),
+		NewImageDisplayName:   util.StringOrNull(img.DisplayName),
+		DryRun:                prior.DryRun,
+		CanUpdateImage:        prior.CanUpdateImage,
+		Tags:                  types.MapNull(types.StringType),
+		TagsAll:               types.MapNull(types.StringType),
+		ARN:                   util.StringOrNull(img.Arn),
+		Platform:              types.StringValue(string(img.Platform)),
+		State:                 types.StringValue(string(img.State)),
+		AppstreamAgentVersion: util.StringOrNull(img.AppstreamAgentVersion),
+		CreatedTime:           util.StringFromTime(img.CreatedTime),
+		ImageErrors:           image.FlattenImageErrors(ctx, img.ImageErrors, &diags),
+	}
+
+	if !state.ARN.IsNull() {
+		tags, tagsAll, tagDiags := r.tags.ReadResource(ctx, state.ARN.ValueString(), prior.Tags)
+		diags.Append(tagDiags...)
+		state.Tags = tags
+		state.TagsAll = tagsAll
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return state, diags
+}
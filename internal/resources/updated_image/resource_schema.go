@@ -0,0 +1,210 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package updated_image
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an AWS AppStream image created by applying the latest updates to an existing image",
+		MarkdownDescription: "Creates a new AppStream image from an existing image with the latest AppStream agent, " +
+			"operating system updates and drivers, using `CreateUpdatedImage`, and waits until the new image is available. " +
+			"Destroying the resource deletes the new image; the existing image is not modified.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the updated AppStream image.",
+				MarkdownDescription: "The identifier of the updated image. This is equal to `new_image_name`. " +
+					"This value is managed by the provider and cannot be set manually.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"existing_image_name": schema.StringAttribute{
+				Description: "Name of the image to update.",
+				MarkdownDescription: "The name of the existing image to apply the updates to. " +
+					"Changing this value forces a new image to be created.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					// aws does not report the existing image, so it is unknown after an import
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the existing image forces a new image unless it is unknown after an import.",
+						"Changing the existing image forces a new image unless it is unknown after an import.",
+					),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"new_image_name": schema.StringAttribute{
+				Description: "Name of the updated image.",
+				MarkdownDescription: "The name of the new, updated image. " +
+					"Changing this value forces a new image to be created.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`),
+						"must match ^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$",
+					),
+				},
+			},
+			"new_image_description": schema.StringAttribute{
+				Description: "Description of the updated image.",
+				MarkdownDescription: "The description of the new, updated image. " +
+					"If not set, the value reported by AWS is used. Changing this value forces a new image to be created.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			"new_image_display_name": schema.StringAttribute{
+				Description: "Display name of the updated image.",
+				MarkdownDescription: "The name displayed to users for the new, updated image. " +
+					"If not set, the value reported by AWS is used. Changing this value forces a new image to be created.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+			},
+			"dry_run": schema.BoolAttribute{
+				Description: "Check whether an update is available before creating the image.",
+				MarkdownDescription: "Whether to first check whether updates are available for the existing image. " +
+					"When `true` and AWS reports `can_update_image = false`, the apply fails and no image is created. " +
+					"Only used during create.",
+				Optional: true,
+			},
+			"can_update_image": schema.BoolAttribute{
+				Description:         "Whether AWS reported an update for the existing image.",
+				MarkdownDescription: "Whether AWS reported that a new, updated image could be created from the existing image.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				Description:         "Tags applied to the updated AppStream image.",
+				MarkdownDescription: "A map of tags assigned to the updated AppStream image.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtMost(50),
+					mapvalidator.KeysAre(
+						stringvalidator.LengthBetween(1, 128),
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`),
+							"must match ^[\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*$",
+						),
+					),
+					mapvalidator.ValueStringsAre(
+						stringvalidator.LengthAtMost(256),
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^([\p{L}\p{Z}\p{N}_.:/=+\-@]*)$`),
+							"must match ^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$",
+						),
+					),
+				},
+			},
+			"tags_all": schema.MapAttribute{
+				Description: "All tags of the updated AppStream image, including provider default tags.",
+				MarkdownDescription: "A map of all tags assigned to the updated AppStream image, " +
+					"including those inherited from the provider `default_tags`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"arn": schema.StringAttribute{
+				Description:         "ARN of the updated image.",
+				MarkdownDescription: "The Amazon Resource Name (ARN) of the updated image. Reference it from fleets and image builders.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"platform": schema.StringAttribute{
+				Description:         "Image platform.",
+				MarkdownDescription: "The operating system platform of the updated image.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description:         "State of the updated image.",
+				MarkdownDescription: "The state of the updated image, for example `AVAILABLE` or `FAILED`.",
+				Computed:            true,
+			},
+			"appstream_agent_version": schema.StringAttribute{
+				Description:         "AppStream agent version.",
+				MarkdownDescription: "The version of the AppStream agent installed on the updated image.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_time": schema.StringAttribute{
+				Description:         "Time the updated image was created.",
+				MarkdownDescription: "The timestamp when the updated image was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_errors": schema.SetNestedAttribute{
+				Description:         "Image errors.",
+				MarkdownDescription: "Errors reported by AWS while creating the updated image.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"error_code": schema.StringAttribute{
+							Description:         "Error code.",
+							MarkdownDescription: "The error code reported by AWS.",
+							Computed:            true,
+						},
+						"error_message": schema.StringAttribute{
+							Description:         "Error message.",
+							MarkdownDescription: "The human-readable error message.",
+							Computed:            true,
+						},
+						"error_timestamp": schema.StringAttribute{
+							Description:         "Error timestamp.",
+							MarkdownDescription: "The time the error occurred, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
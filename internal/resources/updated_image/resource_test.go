@@ -0,0 +1,61 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package updated_image_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccUpdatedImageConfig(existingImageName, name string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_updated_image" "test" {
+  existing_image_name   = %q
+  new_image_name        = %q
+  new_image_description = "updated by terraform acceptance tests"
+
+  tags = {
+    Environment = "test"
+  }
+}
+`, existingImageName, name)
+}
+
+func TestAccUpdatedImage_basic(t *testing.T) {
+	existingImageName := testhelpers.TestAccPrivateImageName(t)
+	name := acctest.RandomWithPrefix("tf-acc-updated-image")
+	resourceName := "awsappstream_updated_image.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUpdatedImageConfig(existingImageName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "existing_image_name", existingImageName),
+					resource.TestCheckResourceAttr(resourceName, "new_image_name", name),
+					resource.TestCheckResourceAttr(resourceName, "new_image_description", "updated by terraform acceptance tests"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "test"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "can_update_image"),
+					resource.TestCheckResourceAttrSet(resourceName, "appstream_agent_version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// aws reports neither the existing image nor the result of the update check
+				ImportStateVerifyIgnore: []string{"existing_image_name", "can_update_image"},
+			},
+		},
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package usage_report_subscription
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (ds *dataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config model
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	subscription, err := describeSubscription(ctx, ds.appstreamClient)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading AWS AppStream Usage Report Subscription",
+			fmt.Sprintf("Could not read usage report subscription of region %q: %v", ds.region, err),
+		)
+		return
+	}
+
+	if subscription == nil {
+		resp.Diagnostics.AddError(
+			"AWS AppStream Usage Report Subscription Not Found",
+			fmt.Sprintf("No usage report subscription exists in region %q.", ds.region),
+		)
+		return
+	}
+
+	state := flattenSubscription(ctx, ds.region, *subscription, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package usage_report_subscription
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (ds *dataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Read the AWS AppStream usage report subscription of a region",
+		MarkdownDescription: "Reads the AppStream usage report subscription of the configured region. " +
+			"The data source returns an error if usage reports are not enabled in the region.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the AppStream usage report subscription.",
+				MarkdownDescription: "A synthetic identifier for the usage report subscription, equal to the configured region.",
+				Computed:            true,
+			},
+			"s3_bucket_name": schema.StringAttribute{
+				Description:         "S3 bucket of the usage reports.",
+				MarkdownDescription: "The name of the Amazon S3 bucket where generated usage reports are stored.",
+				Computed:            true,
+			},
+			"schedule": schema.StringAttribute{
+				Description:         "Schedule of the usage reports.",
+				MarkdownDescription: "The schedule for generating usage reports. Currently always `DAILY`.",
+				Computed:            true,
+			},
+			"last_generated_report_date": schema.StringAttribute{
+				Description:         "Time the last usage report was generated.",
+				MarkdownDescription: "The time when the last usage report was generated, in RFC3339 format.",
+				Computed:            true,
+			},
+			"subscription_errors": schema.SetNestedAttribute{
+				Description:         "Errors of the usage report generation.",
+				MarkdownDescription: "The errors that were returned if usage reports couldn't be generated.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"error_code": schema.StringAttribute{
+							Description:         "Error code.",
+							MarkdownDescription: "The error code for the error that is returned when a usage report can't be generated.",
+							Computed:            true,
+						},
+						"error_message": schema.StringAttribute{
+							Description:         "Error message.",
+							MarkdownDescription: "The error message for the error that is returned when a usage report can't be generated.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package usage_report_subscription
+
+import (
+	"context"
+
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+)
+
+// describeSubscription returns the usage report subscription of the configured region, or nil if there is none.
+func describeSubscription(ctx context.Context, client metadata.AppStreamAPI) (*awstypes.UsageReportSubscription, error) {
+	var nextToken *string
+
+	for {
+		out, err := client.DescribeUsageReportSubscriptions(ctx, &awsappstream.DescribeUsageReportSubscriptionsInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// an account has at most one subscription per region
+		if len(out.UsageReportSubscriptions) > 0 {
+			return &out.UsageReportSubscriptions[0], nil
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			return nil, nil
+		}
+		nextToken = out.NextToken
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package usage_report_subscription
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var subscriptionErrorObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"error_code":    types.StringType,
+		"error_message": types.StringType,
+	},
+}
+
+func flattenSubscription(ctx context.Context, region string, s awstypes.UsageReportSubscription, diags *diag.Diagnostics) *model {
+	state := &model{
+		ID:                      types.StringValue(region),
+		S3BucketName:            util.StringOrNull(s.S3BucketName),
+		Schedule:                types.StringNull(),
+		LastGeneratedReportDate: util.StringFromTime(s.LastGeneratedReportDate),
+		SubscriptionErrors:      flattenSubscriptionErrors(ctx, s.SubscriptionErrors, diags),
+	}
+
+	if s.Schedule != "" {
+		state.Schedule = types.StringValue(string(s.Schedule))
+	}
+
+	return state
+}
+
+func flattenSubscriptionErrors(
+	ctx context.Context, awsErrors []awstypes.LastReportGenerationExecutionError, diags *diag.Diagnostics,
+) types.Set {
+
+	// a healthy subscription has no errors. that's an empty set, not null
+	out := make([]subscriptionErrorModel, 0, len(awsErrors))
+	for _, e := range awsErrors {
+		errorCode := types.StringNull()
+		if e.ErrorCode != "" {
+			errorCode = types.StringValue(string(e.ErrorCode))
+		}
+
+		out = append(out, subscriptionErrorModel{
+			ErrorCode:    errorCode,
+			ErrorMessage: util.StringOrNull(e.ErrorMessage),
+		})
+	}
+
+	setVal, d := types.SetValueFrom(ctx, subscriptionErrorObjectType, out)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.SetNull(subscriptionErrorObjectType)
+	}
+
+	return setVal
+}
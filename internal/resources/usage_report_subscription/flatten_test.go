@@ -0,0 +1,66 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package usage_report_subscription
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenSubscription(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty_errors_is_not_null", func(t *testing.T) {
+		var diags diag.Diagnostics
+		got := flattenSubscription(ctx, "eu-central-1", awstypes.UsageReportSubscription{}, &diags)
+		require.False(t, diags.HasError())
+		require.Equal(t, "eu-central-1", got.ID.ValueString())
+		require.True(t, got.S3BucketName.IsNull())
+		require.True(t, got.Schedule.IsNull())
+		require.True(t, got.LastGeneratedReportDate.IsNull())
+		require.False(t, got.SubscriptionErrors.IsNull())
+		require.Empty(t, got.SubscriptionErrors.Elements())
+	})
+
+	t.Run("full", func(t *testing.T) {
+		var diags diag.Diagnostics
+		generated := time.Date(2024, 6, 17, 8, 0, 0, 0, time.UTC)
+		got := flattenSubscription(ctx, "eu-central-1", awstypes.UsageReportSubscription{
+			S3BucketName:            aws.String("appstream-logs-eu-central-1-111111111111-abcdefgh"),
+			Schedule:                awstypes.UsageReportScheduleDaily,
+			LastGeneratedReportDate: &generated,
+			SubscriptionErrors: []awstypes.LastReportGenerationExecutionError{
+				{
+					ErrorCode:    awstypes.UsageReportExecutionErrorCodeAccessDenied,
+					ErrorMessage: aws.String("access denied"),
+				},
+				{
+					ErrorCode: awstypes.UsageReportExecutionErrorCodeInternalServiceError,
+				},
+			},
+		}, &diags)
+		require.False(t, diags.HasError())
+		require.Equal(t, "appstream-logs-eu-central-1-111111111111-abcdefgh", got.S3BucketName.ValueString())
+		require.Equal(t, "DAILY", got.Schedule.ValueString())
+		require.Equal(t, "2024-06-17T08:00:00Z", got.LastGeneratedReportDate.ValueString())
+
+		var errs []subscriptionErrorModel
+		diags.Append(got.SubscriptionErrors.ElementsAs(ctx, &errs, false)...)
+		require.False(t, diags.HasError())
+		require.Len(t, errs, 2)
+
+		byCode := map[string]subscriptionErrorModel{}
+		for _, e := range errs {
+			byCode[e.ErrorCode.ValueString()] = e
+		}
+		require.Equal(t, "access denied", byCode["ACCESS_DENIED"].ErrorMessage.ValueString())
+		require.True(t, byCode["INTERNAL_SERVICE_ERROR"].ErrorMessage.IsNull())
+	})
+}
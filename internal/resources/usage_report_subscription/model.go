@@ -0,0 +1,26 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package usage_report_subscription
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type model struct {
+	// ID is the region of the usage report subscription.
+	ID types.String `tfsdk:"id"`
+	// S3BucketName is the S3 bucket the usage reports are stored in (computed).
+	S3BucketName types.String `tfsdk:"s3_bucket_name"`
+	// Schedule is the schedule for generating usage reports (computed).
+	Schedule types.String `tfsdk:"schedule"`
+	// LastGeneratedReportDate is the time the last usage report was generated (computed).
+	LastGeneratedReportDate types.String `tfsdk:"last_generated_report_date"`
+	// SubscriptionErrors are the errors returned if usage reports couldn't be generated (computed).
+	SubscriptionErrors types.Set `tfsdk:"subscription_errors"`
+}
+
+type subscriptionErrorModel struct {
+	// ErrorCode is the error code returned when a usage report can't be generated.
+	ErrorCode types.String `tfsdk:"error_code"`
+	// ErrorMessage is the error message returned when a usage report can't be generated.
+	ErrorMessage types.String `tfsdk:"error_message"`
+}
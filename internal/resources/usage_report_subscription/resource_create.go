@@ -0,0 +1,100 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package usage_report_subscription
+
+import (
+	"context"
+	"fmt"
+
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (r *resource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	var plan model
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	// CreateUsageReportSubscription succeeds on an existing subscription. check first so that
+	// two configurations do not silently manage the same singleton
+	existing, err := describeSubscription(ctx, r.appstreamClient)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating AWS AppStream Usage Report Subscription",
+			fmt.Sprintf("Could not read usage report subscription of region %q: %v", r.region, err),
+		)
+		return
+	}
+
+	if existing != nil {
+		resp.Diagnostics.AddError(
+			"AWS AppStream Usage Report Subscription Already Exists",
+			fmt.Sprintf(
+				"A usage report subscription already exists in region %q. "+
+					"To manage it with Terraform, import it using:\n\n"+
+					"  terraform import <resource_address> %s",
+				r.region, r.region,
+			),
+		)
+		return
+	}
+
+	// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_CreateUsageReportSubscription.html
+	err = util.RetryOn(
+		ctx,
+		func(ctx context.Context) error {
+			_, err := r.appstreamClient.CreateUsageReportSubscription(ctx, &awsappstream.CreateUsageReportSubscriptionInput{})
+			return err
+		},
+		util.WithTimeout(createRetryTimeout),
+		util.WithInitBackoff(createRetryInitBackoff),
+		util.WithMaxBackoff(createRetryMaxBackoff),
+		util.WithRetryOnFns(
+			util.IsConcurrentModificationException,
+		),
+	)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating AWS AppStream Usage Report Subscription",
+			fmt.Sprintf("Could not create usage report subscription in region %q: %v", r.region, err),
+		)
+		return
+	}
+
+	newState, diags := r.readSubscription(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if newState == nil {
+		if ctx.Err() != nil {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"AWS AppStream Usage Report Subscription Not Found After Creation",
+			fmt.Sprintf("The usage report subscription of region %q was created but could not be read back.", r.region),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
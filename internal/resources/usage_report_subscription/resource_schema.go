@@ -0,0 +1,73 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package usage_report_subscription
+
+import (
+	"context"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func (r *resource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the AWS AppStream usage report subscription of a region",
+		MarkdownDescription: "Manages the AppStream usage report subscription of the configured region. " +
+			"While the subscription exists, AppStream generates daily usage reports and stores them in an S3 bucket. " +
+			"An account has at most one subscription per region, so this resource is a singleton.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the AppStream usage report subscription.",
+				MarkdownDescription: "A synthetic identifier for the usage report subscription, equal to the configured region. " +
+					"This value is managed by the provider and cannot be set manually.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"s3_bucket_name": schema.StringAttribute{
+				Description:         "S3 bucket of the usage reports.",
+				MarkdownDescription: "The name of the Amazon S3 bucket where generated usage reports are stored.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Description:         "Schedule of the usage reports.",
+				MarkdownDescription: "The schedule for generating usage reports. Currently always `DAILY`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_generated_report_date": schema.StringAttribute{
+				Description:         "Time the last usage report was generated.",
+				MarkdownDescription: "The time when the last usage report was generated, in RFC3339 format.",
+				Computed:            true,
+			},
+			"subscription_errors": schema.SetNestedAttribute{
+				Description:         "Errors of the usage report generation.",
+				MarkdownDescription: "The errors that were returned if usage reports couldn't be generated.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"error_code": schema.StringAttribute{
+							Description:         "Error code.",
+							MarkdownDescription: "The error code for the error that is returned when a usage report can't be generated.",
+							Computed:            true,
+						},
+						"error_message": schema.StringAttribute{
+							Description:         "Error message.",
+							MarkdownDescription: "The error message for the error that is returned when a usage report can't be generated.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
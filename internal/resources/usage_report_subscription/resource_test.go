@@ -0,0 +1,49 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package usage_report_subscription_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccUsageReportSubscriptionConfig() string {
+	return testhelpers.TestAccProviderBasicConfig() + `
+resource "awsappstream_usage_report_subscription" "test" {}
+
+data "awsappstream_usage_report_subscription" "test" {
+  depends_on = [awsappstream_usage_report_subscription.test]
+}
+`
+}
+
+func TestAccUsageReportSubscription_basic(t *testing.T) {
+	resourceName := "awsappstream_usage_report_subscription.test"
+	dataSourceName := "data.awsappstream_usage_report_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageReportSubscriptionConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_bucket_name"),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "DAILY"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "s3_bucket_name", resourceName, "s3_bucket_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schedule", resourceName, "schedule"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,16 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package usage_report_subscription
+
+import "time"
+
+const (
+	createRetryTimeout     = 2 * time.Minute
+	createRetryInitBackoff = 1 * time.Second
+	createRetryMaxBackoff  = 10 * time.Second
+
+	deleteRetryTimeout     = 2 * time.Minute
+	deleteRetryInitBackoff = 1 * time.Second
+	deleteRetryMaxBackoff  = 10 * time.Second
+)
@@ -0,0 +1,63 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+var userObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"user_name":           types.StringType,
+		"authentication_type": types.StringType,
+		"arn":                 types.StringType,
+		"first_name":          types.StringType,
+		"last_name":           types.StringType,
+		"enabled":             types.BoolType,
+		"status":              types.StringType,
+		"created_time":        types.StringType,
+	},
+}
+
+// flattenUsers returns the user names, ARNs and key attributes of users. Empty results are empty lists, not null.
+func flattenUsers(
+	ctx context.Context, users []awstypes.User, diags *diag.Diagnostics,
+) (userNames types.List, arns types.List, userList types.List) {
+	userNameValues := make([]string, 0, len(users))
+	arnValues := make([]string, 0, len(users))
+	models := make([]userModel, 0, len(users))
+
+	for _, user := range users {
+		userNameValues = append(userNameValues, aws.ToString(user.UserName))
+		arnValues = append(arnValues, aws.ToString(user.Arn))
+		models = append(models, userModel{
+			UserName:           util.StringOrNull(user.UserName),
+			AuthenticationType: types.StringValue(string(user.AuthenticationType)),
+			ARN:                util.StringOrNull(user.Arn),
+			FirstName:          util.StringOrNull(user.FirstName),
+			LastName:           util.StringOrNull(user.LastName),
+			Enabled:            util.BoolOrNull(user.Enabled),
+			Status:             util.StringOrNull(user.Status),
+			CreatedTime:        util.StringFromTime(user.CreatedTime),
+		})
+	}
+
+	userNames, d := types.ListValueFrom(ctx, types.StringType, userNameValues)
+	diags.Append(d...)
+
+	arns, d = types.ListValueFrom(ctx, types.StringType, arnValues)
+	diags.Append(d...)
+
+	userList, d = types.ListValueFrom(ctx, userObjectType, models)
+	diags.Append(d...)
+
+	return userNames, arns, userList
+}
@@ -0,0 +1,67 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenUsers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty_is_not_null", func(t *testing.T) {
+		var diags diag.Diagnostics
+		userNames, arns, users := flattenUsers(ctx, nil, &diags)
+		require.False(t, diags.HasError())
+		require.False(t, userNames.IsNull())
+		require.Empty(t, userNames.Elements())
+		require.False(t, arns.IsNull())
+		require.Empty(t, arns.Elements())
+		require.False(t, users.IsNull())
+		require.Empty(t, users.Elements())
+	})
+
+	t.Run("key_attributes", func(t *testing.T) {
+		created := time.Date(2024, 6, 17, 8, 0, 0, 0, time.UTC)
+
+		var diags diag.Diagnostics
+		userNames, arns, users := flattenUsers(ctx, []awstypes.User{
+			{
+				UserName:           aws.String("jane.doe@example.com"),
+				AuthenticationType: awstypes.AuthenticationTypeUserpool,
+				Arn:                aws.String("arn:jane"),
+				FirstName:          aws.String("Jane"),
+				LastName:           aws.String("Doe"),
+				Enabled:            aws.Bool(true),
+				Status:             aws.String("CONFIRMED"),
+				CreatedTime:        &created,
+			},
+		}, &diags)
+		require.False(t, diags.HasError())
+
+		require.Equal(t, []attr.Value{types.StringValue("jane.doe@example.com")}, userNames.Elements())
+		require.Equal(t, []attr.Value{types.StringValue("arn:jane")}, arns.Elements())
+
+		var models []userModel
+		diags.Append(users.ElementsAs(ctx, &models, false)...)
+		require.False(t, diags.HasError())
+		require.Len(t, models, 1)
+
+		require.Equal(t, "USERPOOL", models[0].AuthenticationType.ValueString())
+		require.Equal(t, "Jane", models[0].FirstName.ValueString())
+		require.Equal(t, "Doe", models[0].LastName.ValueString())
+		require.True(t, models[0].Enabled.ValueBool())
+		require.Equal(t, "CONFIRMED", models[0].Status.ValueString())
+		require.Equal(t, "2024-06-17T08:00:00Z", models[0].CreatedTime.ValueString())
+	})
+}
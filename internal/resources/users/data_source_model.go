@@ -0,0 +1,32 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type dataSourceModel struct {
+	// ID is a synthetic identifier equal to the authentication type.
+	ID types.String `tfsdk:"id"`
+	// AuthenticationType is the authentication type of the users to list (required).
+	AuthenticationType types.String `tfsdk:"authentication_type"`
+	// NameRegex only selects users whose user name matches the regular expression (optional).
+	NameRegex types.String `tfsdk:"name_regex"`
+	// UserNames are the user names of the matching users (computed).
+	UserNames types.List `tfsdk:"user_names"`
+	// ARNs are the ARNs of the matching users (computed).
+	ARNs types.List `tfsdk:"arns"`
+	// Users are the key attributes of the matching users (computed).
+	Users types.List `tfsdk:"users"`
+}
+
+type userModel struct {
+	UserName           types.String `tfsdk:"user_name"`
+	AuthenticationType types.String `tfsdk:"authentication_type"`
+	ARN                types.String `tfsdk:"arn"`
+	FirstName          types.String `tfsdk:"first_name"`
+	LastName           types.String `tfsdk:"last_name"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Status             types.String `tfsdk:"status"`
+	CreatedTime        types.String `tfsdk:"created_time"`
+}
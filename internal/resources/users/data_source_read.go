@@ -0,0 +1,108 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (ds *dataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config dataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	if config.AuthenticationType.IsNull() || config.AuthenticationType.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Configuration",
+			"Cannot read users because authentication_type must be set and known.",
+		)
+		return
+	}
+
+	regex, err := util.RegexpOrNil(config.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Terraform Configuration",
+			fmt.Sprintf("Could not compile name_regex: %v", err),
+		)
+		return
+	}
+
+	authenticationType := config.AuthenticationType.ValueString()
+
+	users, err := ds.listUsers(ctx, awstypes.AuthenticationType(authenticationType), regex)
+	if err != nil {
+		if util.IsContextCanceled(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading AWS AppStream Users",
+			fmt.Sprintf("Could not list users with authentication type %q: %v", authenticationType, err),
+		)
+		return
+	}
+
+	config.ID = types.StringValue(authenticationType)
+	config.UserNames, config.ARNs, config.Users = flattenUsers(ctx, users, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listUsers pages through DescribeUsers and returns the users matching regex, sorted by user name.
+func (ds *dataSource) listUsers(
+	ctx context.Context, authenticationType awstypes.AuthenticationType, regex *regexp.Regexp,
+) ([]awstypes.User, error) {
+	var out []awstypes.User
+	var nextToken *string
+
+	for {
+		// see https://docs.aws.amazon.com/appstream2/latest/APIReference/API_DescribeUsers.html
+		resp, err := ds.appstreamClient.DescribeUsers(ctx, &awsappstream.DescribeUsersInput{
+			AuthenticationType: authenticationType,
+			NextToken:          nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range resp.Users {
+			if regex != nil && !regex.MatchString(aws.ToString(user.UserName)) {
+				continue
+			}
+			out = append(out, user)
+		}
+
+		if resp.NextToken == nil || *resp.NextToken == "" {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return aws.ToString(out[i].UserName) < aws.ToString(out[j].UserName)
+	})
+
+	return out, nil
+}
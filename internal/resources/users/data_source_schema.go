@@ -0,0 +1,114 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/util"
+)
+
+func (ds *dataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List AWS AppStream Users",
+		MarkdownDescription: "Lists the AppStream users of an authentication type. " +
+			"AppStream users cannot be tagged, so users can only be filtered by user name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier of the AppStream users.",
+				MarkdownDescription: "A synthetic identifier for the users, equal to the authentication type.",
+				Computed:            true,
+			},
+			"authentication_type": schema.StringAttribute{
+				Description: "Authentication type of the users.",
+				MarkdownDescription: "The authentication type of the users to list. " +
+					"Valid values are `API`, `SAML`, `USERPOOL`, or `AWS_AD`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"API",
+						"SAML",
+						"USERPOOL",
+						"AWS_AD",
+					),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression to match user names.",
+				MarkdownDescription: "A regular expression used to match the user names (email addresses) of the users. " +
+					"Uses Go regular expression syntax. User names are **case-sensitive**.",
+				Optional: true,
+				Validators: []validator.String{
+					util.ValidRegex(),
+				},
+			},
+			"user_names": schema.ListAttribute{
+				Description:         "User names of the AppStream users.",
+				MarkdownDescription: "The user names of the matching users, sorted by user name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"arns": schema.ListAttribute{
+				Description:         "ARNs of the AppStream users.",
+				MarkdownDescription: "The ARNs of the matching users, in the same order as `user_names`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"users": schema.ListNestedAttribute{
+				Description:         "AppStream users.",
+				MarkdownDescription: "The key attributes of the matching users, in the same order as `user_names`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_name": schema.StringAttribute{
+							Description:         "User name (email address).",
+							MarkdownDescription: "The email address of the AppStream user.",
+							Computed:            true,
+						},
+						"authentication_type": schema.StringAttribute{
+							Description:         "Authentication type for the user.",
+							MarkdownDescription: "The authentication type associated with the user.",
+							Computed:            true,
+						},
+						"arn": schema.StringAttribute{
+							Description:         "ARN of the AppStream user.",
+							MarkdownDescription: "The ARN of the user.",
+							Computed:            true,
+						},
+						"first_name": schema.StringAttribute{
+							Description:         "First name of the user.",
+							MarkdownDescription: "The first (given) name of the user.",
+							Computed:            true,
+						},
+						"last_name": schema.StringAttribute{
+							Description:         "Last name of the user.",
+							MarkdownDescription: "The last (family) name of the user.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description:         "Whether the user is enabled.",
+							MarkdownDescription: "Indicates whether the user is enabled.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							Description:         "User status.",
+							MarkdownDescription: "The status of the user as reported by AWS.",
+							Computed:            true,
+						},
+						"created_time": schema.StringAttribute{
+							Description:         "Time the user was created.",
+							MarkdownDescription: "The timestamp when the user was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
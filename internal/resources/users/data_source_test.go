@@ -0,0 +1,59 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/testhelpers"
+)
+
+func testAccUsersDataSourceConfig(userName string) string {
+	return testhelpers.TestAccProviderBasicConfig() + fmt.Sprintf(`
+resource "awsappstream_user" "test" {
+  authentication_type = "USERPOOL"
+  user_name           = %[1]q
+  first_name          = "Jane"
+}
+
+data "awsappstream_users" "test" {
+  authentication_type = awsappstream_user.test.authentication_type
+  name_regex          = %[2]q
+}
+
+data "awsappstream_users" "no_match" {
+  authentication_type = awsappstream_user.test.authentication_type
+  name_regex          = %[3]q
+}
+`, userName, "^"+regexp.QuoteMeta(userName)+"$", "^"+regexp.QuoteMeta(userName)+"-missing$")
+}
+
+func TestAccUsersDataSource_basic(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tf-acc-users-ds") + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testhelpers.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testhelpers.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig(userName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.awsappstream_users.test", "id", "USERPOOL"),
+					resource.TestCheckResourceAttr("data.awsappstream_users.test", "user_names.#", "1"),
+					resource.TestCheckResourceAttr("data.awsappstream_users.test", "user_names.0", userName),
+					resource.TestCheckResourceAttrPair(
+						"data.awsappstream_users.test", "arns.0",
+						"awsappstream_user.test", "arn",
+					),
+					resource.TestCheckResourceAttr("data.awsappstream_users.test", "users.0.first_name", "Jane"),
+					resource.TestCheckResourceAttr("data.awsappstream_users.no_match", "users.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,39 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FilterByResourceType reads the tags of resources, which are all of resourceType, keyed by ARN.
+// A single tagging call returns the tags of every resource of the type, so plural data sources resolve
+// tags for all of their resources at once. If filter is not empty only the resources carrying all of
+// its tags are returned.
+func FilterByResourceType[T any](
+	ctx context.Context, tm *TagManager, resourceType string, filter types.Map, resources []T, arn func(T) *string,
+) ([]T, map[string]types.Map, diag.Diagnostics) {
+	tagsByARN, diags := tm.ReadByResourceType(ctx, resourceType, filter)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	if len(filter.Elements()) == 0 {
+		return resources, tagsByARN, diags
+	}
+
+	// the tagging lookup only returns resources matching the filter
+	out := make([]T, 0, len(resources))
+	for _, r := range resources {
+		if _, ok := tagsByARN[aws.ToString(arn(r))]; ok {
+			out = append(out, r)
+		}
+	}
+
+	return out, tagsByARN, diags
+}
@@ -0,0 +1,45 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"slices"
+	"strings"
+)
+
+// IgnoreTags describes tag keys managed outside of Terraform, e.g. by AWS Backup or cost tooling.
+// Matching keys are neither read into state nor added, updated or removed.
+type IgnoreTags struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+func (it IgnoreTags) Ignored(key string) bool {
+	if slices.Contains(it.Keys, key) {
+		return true
+	}
+
+	for _, prefix := range it.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (it IgnoreTags) filter(tags map[string]string) map[string]string {
+	if len(it.Keys) == 0 && len(it.KeyPrefixes) == 0 {
+		return tags
+	}
+
+	out := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if !it.Ignored(k) {
+			out[k] = v
+		}
+	}
+
+	return out
+}
@@ -6,9 +6,16 @@ package tags
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstaggingapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstaggingtypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -29,10 +36,11 @@ type taggingAPI interface {
 type TagManager struct {
 	client      taggingAPI
 	defaultTags map[string]string
+	ignoreTags  IgnoreTags
 }
 
-func NewTagManager(taggingAPI taggingAPI, defaultTags map[string]string) *TagManager {
-	return &TagManager{taggingAPI, defaultTags}
+func NewTagManager(taggingAPI taggingAPI, defaultTags map[string]string, ignoreTags IgnoreTags) *TagManager {
+	return &TagManager{taggingAPI, defaultTags, ignoreTags}
 }
 
 func (tm *TagManager) Read(ctx context.Context, arn string) (types.Map, diag.Diagnostics) {
@@ -44,6 +52,148 @@ func (tm *TagManager) Read(ctx context.Context, arn string) (types.Map, diag.Dia
 	return flattenTags(ctx, tags, &diags), diags
 }
 
+// ReadResource reads the tags of a managed resource. tagsAll contains every remote tag. tags contains only
+// the tags owned by the resource itself: remote tags matching a default tag are left out unless prior,
+// the resource tags known so far, contains the key.
+func (tm *TagManager) ReadResource(
+	ctx context.Context, arn string, prior types.Map,
+) (tags types.Map, tagsAll types.Map, diags diag.Diagnostics) {
+	all, diags := tm.readRaw(ctx, arn)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), types.MapNull(types.StringType), diags
+	}
+
+	var priorTags map[string]string
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorTags = expandTags(ctx, prior, &diags)
+		if diags.HasError() {
+			return types.MapNull(types.StringType), types.MapNull(types.StringType), diags
+		}
+	}
+
+	resourceTags := make(map[string]string)
+	for k, v := range all {
+		if _, ok := priorTags[k]; !ok {
+			if defaultVal, ok := tm.defaultTags[k]; ok && defaultVal == v {
+				continue
+			}
+		}
+		resourceTags[k] = v
+	}
+
+	tags = flattenTags(ctx, resourceTags, &diags)
+	if tags.IsNull() && !prior.IsNull() && !prior.IsUnknown() {
+		// keep an explicitly configured empty map
+		tags = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+
+	return tags, flattenTags(ctx, all, &diags), diags
+}
+
+// PlanTagsAll returns the tags that will be applied for the planned resource tags,
+// including the default tags and excluding ignored tags.
+func (tm *TagManager) PlanTagsAll(ctx context.Context, planned types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if planned.IsUnknown() {
+		return types.MapUnknown(types.StringType), diags
+	}
+
+	for _, v := range planned.Elements() {
+		if v.IsUnknown() {
+			return types.MapUnknown(types.StringType), diags
+		}
+	}
+
+	desiredTags := tm.defaultTags
+	if !planned.IsNull() {
+		resourceTags := expandTags(ctx, planned, &diags)
+		if diags.HasError() {
+			return types.MapNull(types.StringType), diags
+		}
+		desiredTags = mergeTags(tm.defaultTags, resourceTags)
+	}
+
+	return flattenTags(ctx, tm.ignoreTags.filter(desiredTags), &diags), diags
+}
+
+// ModifyPlan sets the planned "tags_all" attribute from the planned "tags" attribute.
+func (tm *TagManager) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll, diags := tm.PlanTagsAll(ctx, planned)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// ReadByResourceType reads the tags of every resource of resourceType, such as "appstream:fleet", keyed by ARN.
+// If filter is not empty only resources carrying all of its tags are returned. Resources without tags are
+// never returned by AWS, so a missing ARN means the resource has no tags or does not match the filter.
+func (tm *TagManager) ReadByResourceType(
+	ctx context.Context, resourceType string, filter types.Map,
+) (map[string]types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	input := &awstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{resourceType},
+	}
+
+	if !filter.IsNull() && !filter.IsUnknown() {
+		filterTags := expandTags(ctx, filter, &diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, k := range slices.Sorted(maps.Keys(filterTags)) {
+			input.TagFilters = append(input.TagFilters, awstaggingtypes.TagFilter{
+				Key:    aws.String(k),
+				Values: []string{filterTags[k]},
+			})
+		}
+	}
+
+	out := make(map[string]types.Map)
+
+	for {
+		page, err := tm.client.GetResources(ctx, input)
+		if err != nil {
+			diags.AddError(
+				"Error Reading AWS Tags",
+				fmt.Sprintf("Could not read tags for resources of type %q: %v", resourceType, err),
+			)
+			return nil, diags
+		}
+
+		for _, m := range page.ResourceTagMappingList {
+			raw := make(map[string]string, len(m.Tags))
+			for _, t := range m.Tags {
+				if t.Key != nil && t.Value != nil {
+					raw[*t.Key] = *t.Value
+				}
+			}
+			out[aws.ToString(m.ResourceARN)] = flattenTags(ctx, tm.ignoreTags.filter(raw), &diags)
+		}
+
+		if page.PaginationToken == nil || *page.PaginationToken == "" {
+			return out, diags
+		}
+		input.PaginationToken = page.PaginationToken
+	}
+}
+
 func (tm *TagManager) readRaw(ctx context.Context, arn string) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -72,7 +222,7 @@ func (tm *TagManager) readRaw(ctx context.Context, arn string) (map[string]strin
 		}
 	}
 
-	return raw, diags
+	return tm.ignoreTags.filter(raw), diags
 }
 
 func (tm *TagManager) Apply(ctx context.Context, arn string, desired types.Map) (types.Map, diag.Diagnostics) {
@@ -102,6 +252,8 @@ func (tm *TagManager) Apply(ctx context.Context, arn string, desired types.Map)
 		desiredTags = mergeTags(tm.defaultTags, resourceTags)
 	}
 
+	desiredTags = tm.ignoreTags.filter(desiredTags)
+
 	removeKeys, addOrUpdate := diffTags(current, desiredTags)
 
 	if len(removeKeys) > 0 {
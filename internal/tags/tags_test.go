@@ -236,7 +236,7 @@ func TestTagManager_Read(t *testing.T) {
 			fake := NewFakeTaggingAPI()
 			tt.setupClient(fake)
 
-			tm := NewTagManager(fake, nil)
+			tm := NewTagManager(fake, nil, IgnoreTags{})
 
 			got, diags := tm.Read(ctx, tt.arn)
 
@@ -267,6 +267,7 @@ func TestTagManager_Apply(t *testing.T) {
 		name        string
 		arn         string
 		defaultTags map[string]string
+		ignoreTags  IgnoreTags
 		desired     types.Map
 		setupClient func(*FakeTaggingAPI)
 		assert      func(t *testing.T, f *FakeTaggingAPI)
@@ -416,6 +417,45 @@ func TestTagManager_Apply(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name:       "ignored_remote_tags_are_kept_and_hidden",
+			arn:        arn,
+			ignoreTags: IgnoreTags{Keys: []string{"backup"}, KeyPrefixes: []string{"cost:"}},
+			desired:    types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("b")}),
+			setupClient: func(f *FakeTaggingAPI) {
+				f.GetResourcesReturns(&awstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []awstypes.ResourceTagMapping{
+						{
+							Tags: []awstypes.Tag{
+								{Key: aws.String("backup"), Value: aws.String("daily")},
+								{Key: aws.String("cost:center"), Value: aws.String("42")},
+								{Key: aws.String("a"), Value: aws.String("b")},
+							},
+						},
+					},
+				})
+			},
+			assert: func(t *testing.T, f *FakeTaggingAPI) {
+				require.Equal(t, 0, f.UntagResourcesCalls)
+				require.Equal(t, 0, f.TagResourcesCalls)
+			},
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("b")}),
+		},
+		{
+			name:        "ignored_desired_tags_are_not_applied",
+			arn:         arn,
+			defaultTags: map[string]string{"backup": "weekly"},
+			ignoreTags:  IgnoreTags{Keys: []string{"backup"}},
+			desired:     types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("b")}),
+			setupClient: func(f *FakeTaggingAPI) {
+				f.GetResourcesReturns(&awstaggingapi.GetResourcesOutput{})
+				f.TagResourcesSucceeds()
+			},
+			assert: func(t *testing.T, f *FakeTaggingAPI) {
+				require.Equal(t, map[string]string{"a": "b"}, f.LastTagResourcesInput.Tags)
+			},
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("b")}),
+		},
 		{
 			name:    "tag_error_returns_diagnostics",
 			arn:     arn,
@@ -435,7 +475,7 @@ func TestTagManager_Apply(t *testing.T) {
 				tt.setupClient(fake)
 			}
 
-			tm := NewTagManager(fake, tt.defaultTags)
+			tm := NewTagManager(fake, tt.defaultTags, tt.ignoreTags)
 
 			got, diags := tm.Apply(ctx, tt.arn, tt.desired)
 
@@ -750,3 +790,323 @@ func TestDiffTags(t *testing.T) {
 		})
 	}
 }
+
+func TestIgnoreTags_Ignored(t *testing.T) {
+	ignore := IgnoreTags{
+		Keys:        []string{"backup"},
+		KeyPrefixes: []string{"aws:", "cost:"},
+	}
+
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{key: "backup", want: true},
+		{key: "backup-plan", want: false},
+		{key: "aws:cloudformation:stack-name", want: true},
+		{key: "cost:center", want: true},
+		{key: "env", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.want, ignore.Ignored(tt.key))
+		})
+	}
+}
+
+func TestTagManager_ReadResource(t *testing.T) {
+	ctx := context.Background()
+	arn := "arn:aws:appstream:eu-central-1:123456789012:stack/test"
+
+	remote := &awstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []awstypes.ResourceTagMapping{
+			{
+				Tags: []awstypes.Tag{
+					{Key: aws.String("env"), Value: aws.String("prod")},
+					{Key: aws.String("team"), Value: aws.String("platform")},
+					{Key: aws.String("owner"), Value: aws.String("alice")},
+				},
+			},
+		},
+	}
+
+	allTags := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"env":   types.StringValue("prod"),
+		"team":  types.StringValue("platform"),
+		"owner": types.StringValue("alice"),
+	})
+
+	tests := []struct {
+		name        string
+		defaultTags map[string]string
+		prior       types.Map
+		wantTags    types.Map
+		wantTagsAll types.Map
+	}{
+		{
+			name:        "no_default_tags",
+			prior:       types.MapNull(types.StringType),
+			wantTags:    allTags,
+			wantTagsAll: allTags,
+		},
+		{
+			name:        "default_tags_are_excluded_from_tags",
+			defaultTags: map[string]string{"env": "prod", "team": "platform"},
+			prior: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"owner": types.StringValue("alice"),
+			}),
+			wantTags: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"owner": types.StringValue("alice"),
+			}),
+			wantTagsAll: allTags,
+		},
+		{
+			name:        "configured_key_overlapping_default_is_kept",
+			defaultTags: map[string]string{"env": "prod"},
+			prior: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env":   types.StringValue("prod"),
+				"owner": types.StringValue("alice"),
+			}),
+			wantTags: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env":   types.StringValue("prod"),
+				"team":  types.StringValue("platform"),
+				"owner": types.StringValue("alice"),
+			}),
+			wantTagsAll: allTags,
+		},
+		{
+			name:        "default_key_with_drifted_value_is_kept",
+			defaultTags: map[string]string{"env": "dev", "team": "platform", "owner": "alice"},
+			prior:       types.MapNull(types.StringType),
+			wantTags: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("prod"),
+			}),
+			wantTagsAll: allTags,
+		},
+		{
+			name:        "configured_empty_map_is_kept",
+			defaultTags: map[string]string{"env": "prod", "team": "platform", "owner": "alice"},
+			prior:       types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			wantTags:    types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			wantTagsAll: allTags,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := NewFakeTaggingAPI().GetResourcesReturns(remote)
+			tm := NewTagManager(fake, tt.defaultTags, IgnoreTags{})
+
+			tags, tagsAll, diags := tm.ReadResource(ctx, arn, tt.prior)
+
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			require.Truef(t, tags.Equal(tt.wantTags), "tags mismatch\nGot:  %#v\nWant: %#v", tags, tt.wantTags)
+			require.Truef(
+				t, tagsAll.Equal(tt.wantTagsAll),
+				"tags_all mismatch\nGot:  %#v\nWant: %#v", tagsAll, tt.wantTagsAll,
+			)
+		})
+	}
+}
+
+func TestTagManager_PlanTagsAll(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		defaultTags map[string]string
+		ignoreTags  IgnoreTags
+		planned     types.Map
+		want        types.Map
+	}{
+		{
+			name:    "no_tags",
+			planned: types.MapNull(types.StringType),
+			want:    types.MapNull(types.StringType),
+		},
+		{
+			name:        "default_tags_only",
+			defaultTags: map[string]string{"env": "prod"},
+			planned:     types.MapNull(types.StringType),
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("prod"),
+			}),
+		},
+		{
+			name:        "resource_tags_override_default_tags",
+			defaultTags: map[string]string{"env": "prod", "team": "platform"},
+			planned: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("dev"),
+			}),
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env":  types.StringValue("dev"),
+				"team": types.StringValue("platform"),
+			}),
+		},
+		{
+			name:        "ignored_tags_are_excluded",
+			defaultTags: map[string]string{"backup": "daily"},
+			ignoreTags:  IgnoreTags{Keys: []string{"backup"}},
+			planned: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("dev"),
+			}),
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("dev"),
+			}),
+		},
+		{
+			name:        "unknown_planned_tags",
+			defaultTags: map[string]string{"env": "prod"},
+			planned:     types.MapUnknown(types.StringType),
+			want:        types.MapUnknown(types.StringType),
+		},
+		{
+			name:        "unknown_planned_tag_value",
+			defaultTags: map[string]string{"env": "prod"},
+			planned: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"owner": types.StringUnknown(),
+			}),
+			want: types.MapUnknown(types.StringType),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := NewTagManager(NewFakeTaggingAPI(), tt.defaultTags, tt.ignoreTags)
+
+			got, diags := tm.PlanTagsAll(ctx, tt.planned)
+
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			require.Truef(t, got.Equal(tt.want), "PlanTagsAll() mismatch\nGot:  %#v\nWant: %#v", got, tt.want)
+		})
+	}
+}
+
+func TestTagManager_ReadByResourceType(t *testing.T) {
+	ctx := context.Background()
+
+	fleetA := "arn:aws:appstream:eu-central-1:123456789012:fleet/a"
+	fleetB := "arn:aws:appstream:eu-central-1:123456789012:fleet/b"
+
+	t.Run("pages_and_filters", func(t *testing.T) {
+		var inputs []awstaggingapi.GetResourcesInput
+		fake := NewFakeTaggingAPI()
+		fake.GetResourcesFn = func(
+			_ context.Context, params *awstaggingapi.GetResourcesInput, _ ...func(*awstaggingapi.Options),
+		) (*awstaggingapi.GetResourcesOutput, error) {
+			inputs = append(inputs, *params)
+			if params.PaginationToken == nil {
+				return &awstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []awstypes.ResourceTagMapping{
+						{
+							ResourceARN: aws.String(fleetA),
+							Tags: []awstypes.Tag{
+								{Key: aws.String("env"), Value: aws.String("prod")},
+								{Key: aws.String("ignored"), Value: aws.String("x")},
+							},
+						},
+					},
+					PaginationToken: aws.String("next"),
+				}, nil
+			}
+			return &awstaggingapi.GetResourcesOutput{
+				ResourceTagMappingList: []awstypes.ResourceTagMapping{
+					{
+						ResourceARN: aws.String(fleetB),
+						Tags: []awstypes.Tag{
+							{Key: aws.String("env"), Value: aws.String("prod")},
+							{Key: aws.String("team"), Value: aws.String("core")},
+						},
+					},
+				},
+			}, nil
+		}
+
+		tm := NewTagManager(fake, nil, IgnoreTags{Keys: []string{"ignored"}})
+
+		filter := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"team": types.StringValue("core"),
+			"env":  types.StringValue("prod"),
+		})
+
+		got, diags := tm.ReadByResourceType(ctx, "appstream:fleet", filter)
+		require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+		require.Len(t, inputs, 2)
+		require.Equal(t, []string{"appstream:fleet"}, inputs[0].ResourceTypeFilters)
+		require.Equal(t, []awstypes.TagFilter{
+			{Key: aws.String("env"), Values: []string{"prod"}},
+			{Key: aws.String("team"), Values: []string{"core"}},
+		}, inputs[0].TagFilters)
+		require.Equal(t, "next", aws.ToString(inputs[1].PaginationToken))
+
+		require.Len(t, got, 2)
+		require.True(t, got[fleetA].Equal(types.MapValueMust(types.StringType, map[string]attr.Value{
+			"env": types.StringValue("prod"),
+		})))
+		require.True(t, got[fleetB].Equal(types.MapValueMust(types.StringType, map[string]attr.Value{
+			"env":  types.StringValue("prod"),
+			"team": types.StringValue("core"),
+		})))
+	})
+
+	t.Run("null_filter_sends_no_tag_filters", func(t *testing.T) {
+		fake := NewFakeTaggingAPI().GetResourcesReturns(&awstaggingapi.GetResourcesOutput{})
+		tm := NewTagManager(fake, nil, IgnoreTags{})
+
+		got, diags := tm.ReadByResourceType(ctx, "appstream:fleet", types.MapNull(types.StringType))
+		require.False(t, diags.HasError())
+		require.Empty(t, got)
+		require.Empty(t, fake.LastGetResourcesInput.TagFilters)
+	})
+
+	t.Run("aws_error_returns_diagnostics", func(t *testing.T) {
+		fake := NewFakeTaggingAPI().GetResourcesFails(errors.New("boom"))
+		tm := NewTagManager(fake, nil, IgnoreTags{})
+
+		_, diags := tm.ReadByResourceType(ctx, "appstream:fleet", types.MapNull(types.StringType))
+		require.True(t, diags.HasError())
+	})
+}
+
+func TestFilterByResourceType(t *testing.T) {
+	ctx := context.Background()
+
+	resources := []string{"arn:a", "arn:b"}
+	arn := func(r string) *string { return aws.String(r) }
+
+	fake := NewFakeTaggingAPI().GetResourcesReturns(&awstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []awstypes.ResourceTagMapping{
+			{
+				ResourceARN: aws.String("arn:b"),
+				Tags:        []awstypes.Tag{{Key: aws.String("env"), Value: aws.String("prod")}},
+			},
+		},
+	})
+	tm := NewTagManager(fake, nil, IgnoreTags{})
+
+	t.Run("without_filter_keeps_all", func(t *testing.T) {
+		got, tagsByARN, diags := FilterByResourceType(ctx, tm, "appstream:fleet", types.MapNull(types.StringType), resources, arn)
+		require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		require.Equal(t, resources, got)
+		require.Contains(t, tagsByARN, "arn:b")
+	})
+
+	t.Run("filter_keeps_tagged", func(t *testing.T) {
+		filter := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"env": types.StringValue("prod"),
+		})
+
+		got, _, diags := FilterByResourceType(ctx, tm, "appstream:fleet", filter, resources, arn)
+		require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		require.Equal(t, []string{"arn:b"}, got)
+	})
+
+	t.Run("aws_error_returns_diagnostics", func(t *testing.T) {
+		tm := NewTagManager(NewFakeTaggingAPI().GetResourcesFails(errors.New("boom")), nil, IgnoreTags{})
+
+		_, _, diags := FilterByResourceType(ctx, tm, "appstream:fleet", types.MapNull(types.StringType), resources, arn)
+		require.True(t, diags.HasError())
+	})
+}
@@ -4,8 +4,12 @@
 package testhelpers
 
 import (
+	"context"
 	"os"
 	"testing"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	awsappstream "github.com/aws/aws-sdk-go-v2/service/appstream"
 )
 
 func TestAccPreCheck(t *testing.T) {
@@ -21,3 +25,108 @@ func TestAccPreCheck(t *testing.T) {
 		t.Fatal("AWS region not set")
 	}
 }
+
+// TestAccSubnetID returns the subnet used by acceptance tests of resources that must
+// be launched into a VPC. The test is skipped when no subnet is configured.
+func TestAccSubnetID(t *testing.T) string {
+	t.Helper()
+
+	subnetID := os.Getenv("APPSTREAM_ACC_SUBNET_ID")
+	if subnetID == "" {
+		t.Skip("APPSTREAM_ACC_SUBNET_ID not set")
+	}
+
+	return subnetID
+}
+
+// TestAccAppBlockS3Bucket returns a bucket AppStream can store app block packages in.
+// The test is skipped when no bucket is configured.
+func TestAccAppBlockS3Bucket(t *testing.T) string {
+	t.Helper()
+
+	bucket := os.Getenv("APPSTREAM_ACC_APP_BLOCK_S3_BUCKET")
+	if bucket == "" {
+		t.Skip("APPSTREAM_ACC_APP_BLOCK_S3_BUCKET not set")
+	}
+
+	return bucket
+}
+
+// TestAccAppStreamClient returns an AppStream client for the acceptance test account, so that
+// tests can change resources outside of terraform.
+func TestAccAppStreamClient(t *testing.T) *awsappstream.Client {
+	t.Helper()
+
+	cfg, err := awsconfig.LoadDefaultConfig(context.Background())
+	if err != nil {
+		t.Fatalf("loading AWS config: %v", err)
+	}
+
+	return awsappstream.NewFromConfig(cfg)
+}
+
+// TestAccPrivateImageName returns a private image owned by the acceptance test account.
+// The test is skipped when no image is configured.
+func TestAccPrivateImageName(t *testing.T) string {
+	t.Helper()
+
+	imageName := os.Getenv("APPSTREAM_ACC_PRIVATE_IMAGE_NAME")
+	if imageName == "" {
+		t.Skip("APPSTREAM_ACC_PRIVATE_IMAGE_NAME not set")
+	}
+
+	return imageName
+}
+
+// TestAccSharedAccountID returns the AWS account acceptance tests share images with.
+// The test is skipped when no account is configured.
+func TestAccSharedAccountID(t *testing.T) string {
+	t.Helper()
+
+	accountID := os.Getenv("APPSTREAM_ACC_SHARED_ACCOUNT_ID")
+	if accountID == "" {
+		t.Skip("APPSTREAM_ACC_SHARED_ACCOUNT_ID not set")
+	}
+
+	return accountID
+}
+
+// TestAccThemeS3Bucket returns a bucket AppStream can read theme images from. The bucket must
+// contain the objects "logo.png" and "favicon.ico". The test is skipped when no bucket is configured.
+func TestAccThemeS3Bucket(t *testing.T) string {
+	t.Helper()
+
+	bucket := os.Getenv("APPSTREAM_ACC_THEME_S3_BUCKET")
+	if bucket == "" {
+		t.Skip("APPSTREAM_ACC_THEME_S3_BUCKET not set")
+	}
+
+	return bucket
+}
+
+// TestAccImageBuilderName returns an existing Windows image builder that supports license included
+// applications. The test is skipped when no image builder is configured.
+func TestAccImageBuilderName(t *testing.T) string {
+	t.Helper()
+
+	imageBuilderName := os.Getenv("APPSTREAM_ACC_IMAGE_BUILDER_NAME")
+	if imageBuilderName == "" {
+		t.Skip("APPSTREAM_ACC_IMAGE_BUILDER_NAME not set")
+	}
+
+	return imageBuilderName
+}
+
+// TestAccStreamingStackFleet returns a stack and a running fleet associated with it that acceptance
+// tests can stream from. The test is skipped when no stack or fleet is configured.
+func TestAccStreamingStackFleet(t *testing.T) (stackName, fleetName string) {
+	t.Helper()
+
+	stackName = os.Getenv("APPSTREAM_ACC_STREAMING_STACK_NAME")
+	fleetName = os.Getenv("APPSTREAM_ACC_STREAMING_FLEET_NAME")
+	if stackName == "" || fleetName == "" {
+		t.Skip("APPSTREAM_ACC_STREAMING_STACK_NAME or APPSTREAM_ACC_STREAMING_FLEET_NAME not set")
+	}
+
+	return stackName, fleetName
+}
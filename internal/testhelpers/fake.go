@@ -0,0 +1,55 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package testhelpers
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/fake"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/metadata"
+	"github.com/st3ffn/terraform-provider-aws-appstream/internal/provider"
+)
+
+// FakeProtoV6ProviderFactories returns provider factories whose clients are served by backend.
+func FakeProtoV6ProviderFactories(backend *fake.Backend) map[string]func() (tfprotov6.ProviderServer, error) {
+	newMetadata := func(_ aws.Config, _ metadata.Endpoints, defaultTags map[string]string) *metadata.Metadata {
+		return backend.Metadata(defaultTags)
+	}
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"awsappstream": providerserver.NewProtocol6WithError(provider.NewWithMetadata("test", newMetadata)()),
+	}
+}
+
+// TestFakePreCheck skips tests against the fake backend if no terraform CLI is available.
+// These tests need no AWS credentials and run without TF_ACC.
+func TestFakePreCheck(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("terraform CLI not found in PATH and TF_ACC_TERRAFORM_PATH not set")
+	}
+}
+
+// TestFakeProviderConfig configures the provider with static dummy credentials so that
+// no AWS credential resolution or STS call happens.
+func TestFakeProviderConfig() string {
+	return `
+provider "awsappstream" {
+  region                      = "` + fake.DefaultRegion + `"
+  access_key                  = "fake"
+  secret_access_key           = "fake"
+  skip_credentials_validation = true
+}
+`
+}
@@ -6,6 +6,7 @@ package testhelpers
 import (
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/st3ffn/terraform-provider-aws-appstream/internal/provider"
 )
 
@@ -13,6 +14,13 @@ var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, erro
 	"awsappstream": providerserver.NewProtocol6WithError(provider.New("test")()),
 }
 
+// ProtoV6ProviderFactoriesWithEcho adds the echo provider, which copies ephemeral values into
+// state so that acceptance tests of ephemeral resources can check them.
+var ProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"awsappstream": providerserver.NewProtocol6WithError(provider.New("test")()),
+	"echo":         echoprovider.NewProviderServer(),
+}
+
 func TestAccProviderBasicConfig() string {
 	return `
 provider "awsappstream" {}
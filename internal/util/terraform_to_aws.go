@@ -5,6 +5,7 @@ package util
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -34,6 +35,15 @@ func StringPointerOrNil(v types.String) *string {
 	return &s
 }
 
+// RegexpOrNil compiles the regular expression in v, such as the name_regex of a data source.
+// It returns nil if v is null or unknown.
+func RegexpOrNil(v types.String) (*regexp.Regexp, error) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+	return regexp.Compile(v.ValueString())
+}
+
 func ExpandStringSetOrNil(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
 	if set.IsNull() || set.IsUnknown() {
 		return nil
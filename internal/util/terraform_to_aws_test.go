@@ -102,6 +102,33 @@ func TestStringPointerOrNil(t *testing.T) {
 	}
 }
 
+func TestRegexpOrNil(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     types.String
+		wantNil   bool
+		wantError bool
+	}{
+		{name: "null_returns_nil", input: types.StringNull(), wantNil: true},
+		{name: "unknown_returns_nil", input: types.StringUnknown(), wantNil: true},
+		{name: "valid_returns_regexp", input: types.StringValue("^prod-")},
+		{name: "invalid_returns_error", input: types.StringValue("("), wantNil: true, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RegexpOrNil(tt.input)
+
+			if (err != nil) != tt.wantError {
+				t.Fatalf("RegexpOrNil(%v) error = %v, want error %v", tt.input, err, tt.wantError)
+			}
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("RegexpOrNil(%v) = %v, want nil %v", tt.input, got, tt.wantNil)
+			}
+		})
+	}
+}
+
 func TestExpandStringSetOrNil(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,63 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ValidateEndpointURL(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %s", err.Error())
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid URL: scheme must be http or https, got %q", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("invalid URL: missing host")
+	}
+
+	return nil
+}
+
+type urlValidator struct{}
+
+func (v urlValidator) Description(_ context.Context) string {
+	return "string must be a valid http or https URL"
+}
+
+func (v urlValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v urlValidator) ValidateString(
+	ctx context.Context, req validator.StringRequest, resp *validator.StringResponse,
+) {
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateEndpointURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.Append(
+			validatordiag.InvalidAttributeValueMatchDiagnostic(
+				req.Path,
+				v.Description(ctx),
+				req.ConfigValue.ValueString(),
+			),
+		)
+	}
+}
+
+func ValidURL() validator.String {
+	return urlValidator{}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) St3ffn
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import "testing"
+
+func TestValidateEndpointURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		wantErr  bool
+	}{
+		{
+			name:     "valid_https",
+			endpoint: "https://appstream2.eu-central-1.amazonaws.com",
+			wantErr:  false,
+		},
+		{
+			name:     "valid_http_with_port",
+			endpoint: "http://localhost:4566",
+			wantErr:  false,
+		},
+		{
+			name:     "valid_with_path",
+			endpoint: "https://vpce-123.appstream2.eu-central-1.vpce.amazonaws.com/",
+			wantErr:  false,
+		},
+		{
+			name:     "invalid_missing_scheme",
+			endpoint: "localhost:4566",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid_scheme",
+			endpoint: "ftp://localhost",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid_missing_host",
+			endpoint: "https://",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid_unparsable",
+			endpoint: "http://[::1",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEndpointURL(tt.endpoint)
+
+			if tt.wantErr && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}